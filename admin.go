@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ReindexTypeForeground locks JIRA while the index is rebuilt
+	ReindexTypeForeground = "FOREGROUND"
+	// ReindexTypeBackground rebuilds the index while JIRA stays available
+	ReindexTypeBackground = "BACKGROUND"
+	// ReindexTypeBackgroundPreferred runs a background reindex if possible, a foreground one otherwise
+	ReindexTypeBackgroundPreferred = "BACKGROUND_PREFERRED"
+)
+
+// AdminService handles administrative operations for the JIRA instance / API.
+// All methods require JIRA administrator permissions.
+type AdminService struct {
+	client *Client
+	apiVersion
+}
+
+// ReindexProgress represents the state of a reindex in JIRA
+type ReindexProgress struct {
+	ProgressURL     string `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int    `json:"currentProgress" structs:"currentProgress"`
+	CurrentSubTask  string `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	Type            string `json:"type,omitempty" structs:"type,omitempty"`
+	SubmittedTime   string `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime       string `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime      string `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Success         bool   `json:"success" structs:"success"`
+}
+
+// Reindex starts a reindex of the given type (see ReindexType* constants) in JIRA.
+// An empty reindexType lets JIRA choose its default.
+// Reindexing is only available on JIRA Server / Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/reindex-reindex
+func (s *AdminService) Reindex(reindexType string) (*ReindexProgress, *Response, error) {
+	apiEndpoint := "rest/api/2/reindex"
+	if reindexType != "" {
+		apiEndpoint += "?type=" + reindexType
+	}
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(ReindexProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, reindexError(resp, err)
+	}
+	return progress, resp, nil
+}
+
+// GetReindexProgress returns the progress of the current or last reindex in JIRA.
+// Reindexing is only available on JIRA Server / Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/reindex-getReindexInfo
+func (s *AdminService) GetReindexProgress() (*ReindexProgress, *Response, error) {
+	apiEndpoint := "rest/api/2/reindex"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(ReindexProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, reindexError(resp, err)
+	}
+	return progress, resp, nil
+}
+
+// reindexError replaces err with a more descriptive error if the reindex
+// endpoint does not exist (JIRA Cloud) or the user is not an administrator.
+func reindexError(resp *Response, err error) error {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Reindexing is not available on this JIRA instance, it is only supported by JIRA Server / Data Center. Status code: %d", resp.StatusCode)
+	}
+	return adminPermissionError(resp, err)
+}
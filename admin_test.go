@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAdminService_Reindex(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/reindex"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint+"?type=BACKGROUND")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"progressUrl":"http://www.example.com/jira/secure/admin/jira/IndexProgress.jspa?taskId=10100","currentProgress":0,"type":"BACKGROUND","submittedTime":"2017-08-03T10:15:00.000+0000","success":false}`)
+	})
+
+	progress, _, err := testClient.Admin.Reindex(ReindexTypeBackground)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if progress.Type != ReindexTypeBackground {
+		t.Errorf("Expected type %s, got %s", ReindexTypeBackground, progress.Type)
+	}
+}
+
+func TestAdminService_GetReindexProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/reindex"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"currentProgress":42,"currentSubTask":"Indexing issues","type":"BACKGROUND","success":false}`)
+	})
+
+	progress, _, err := testClient.Admin.GetReindexProgress()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if progress.CurrentProgress != 42 {
+		t.Errorf("Expected progress 42, got %d", progress.CurrentProgress)
+	}
+}
+
+func TestAdminService_GetReindexProgress_NotAvailable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/reindex", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := testClient.Admin.GetReindexProgress()
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "Data Center") {
+		t.Errorf("Expected a descriptive error, got %s", err)
+	}
+}
@@ -68,6 +68,7 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 	}
 
 	s.client.session = session
+	s.client.User.clearSelf()
 
 	return true, nil
 }
@@ -104,6 +105,7 @@ func (s *AuthenticationService) Logout() error {
 
 	// If logout successfull, delete session
 	s.client.session = nil
+	s.client.User.clearSelf()
 
 	return nil
 
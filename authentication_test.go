@@ -257,6 +257,31 @@ func TestAuthenticationService_Logout_Success(t *testing.T) {
 	}
 }
 
+func TestAuthenticationService_Logout_ClearsSelf(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"12345678901234567890"}}`)
+		}
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"fred"}`)
+	})
+
+	testClient.Authentication.AcquireSessionCookie("foo", "bar")
+	testClient.User.GetSelf()
+	if err := testClient.Authentication.Logout(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if testClient.User.self != nil {
+		t.Errorf("Expected the cached user to be cleared, got %+v", testClient.User.self)
+	}
+}
+
 func TestAuthenticationService_Logout_FailWithoutLogin(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// AvatarTypeProject represents the avatar type of projects
+	AvatarTypeProject = "project"
+	// AvatarTypeIssueType represents the avatar type of issue types
+	AvatarTypeIssueType = "issuetype"
+	// AvatarTypeUser represents the avatar type of users
+	AvatarTypeUser = "user"
+)
+
+// AvatarService handles avatars for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/avatar
+type AvatarService struct {
+	client *Client
+	apiVersion
+}
+
+// Avatars represents a set of avatars, split into the avatars provided by JIRA and the ones uploaded by users
+type Avatars struct {
+	System []Avatar `json:"system,omitempty" structs:"system,omitempty"`
+	Custom []Avatar `json:"custom,omitempty" structs:"custom,omitempty"`
+}
+
+// Avatar represents a single avatar
+type Avatar struct {
+	ID             string     `json:"id,omitempty" structs:"id,omitempty"`
+	Owner          string     `json:"owner,omitempty" structs:"owner,omitempty"`
+	IsSystemAvatar bool       `json:"isSystemAvatar,omitempty" structs:"isSystemAvatar,omitempty"`
+	IsSelected     bool       `json:"isSelected,omitempty" structs:"isSelected,omitempty"`
+	IsDeletable    bool       `json:"isDeletable,omitempty" structs:"isDeletable,omitempty"`
+	URLs           AvatarUrls `json:"urls,omitempty" structs:"urls,omitempty"`
+}
+
+// GetSystemAvatars returns all system avatars of the given avatarType.
+// Valid avatar types are AvatarTypeProject, AvatarTypeIssueType and AvatarTypeUser.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/avatar-getAllSystemAvatars
+func (s *AvatarService) GetSystemAvatars(avatarType string) (*Avatars, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/avatar/%s/system", avatarType)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(Avatars)
+	resp, err := s.client.Do(req, avatars)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatars, resp, nil
+}
+
+// SetAvatar sets the avatar with the given avatarID for an entity of the given avatarType.
+// entityID is the project id or key for AvatarTypeProject, the issue type id for AvatarTypeIssueType
+// and the username for AvatarTypeUser.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-updateProjectAvatar
+func (s *AvatarService) SetAvatar(avatarType, entityID, avatarID string) (*Response, error) {
+	var apiEndpoint string
+	var payload interface{}
+
+	switch avatarType {
+	case AvatarTypeProject:
+		apiEndpoint = fmt.Sprintf("rest/api/2/project/%s/avatar", entityID)
+		payload = &Avatar{ID: avatarID}
+	case AvatarTypeIssueType:
+		apiEndpoint = fmt.Sprintf("rest/api/2/issuetype/%s", entityID)
+		payload = struct {
+			AvatarID string `json:"avatarId"`
+		}{avatarID}
+	case AvatarTypeUser:
+		apiEndpoint = fmt.Sprintf("rest/api/2/user/avatar?username=%s", url.QueryEscape(entityID))
+		payload = &Avatar{ID: avatarID}
+	default:
+		return nil, fmt.Errorf("Unknown avatar type: %s", avatarType)
+	}
+
+	req, err := s.client.NewRequest("PUT", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	return resp, err
+}
@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAvatarService_GetSystemAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/avatar/project/system", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/avatar/project/system")
+
+		fmt.Fprint(w, `{"system":[{"id":"1000","isSystemAvatar":true,"isSelected":false,"isDeletable":false,"urls":{"16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&avatarId=10040&avatarType=project","24x24":"http://www.example.com/jira/secure/useravatar?size=small&avatarId=10040&avatarType=project","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&avatarId=10040&avatarType=project","48x48":"http://www.example.com/jira/secure/useravatar?avatarId=10040&avatarType=project"}}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetSystemAvatars(AvatarTypeProject)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(avatars.System) != 1 {
+		t.Fatalf("Expected 1 system avatar, got %d", len(avatars.System))
+	}
+	if len(avatars.Custom) != 0 {
+		t.Errorf("Expected no custom avatars, got %d", len(avatars.Custom))
+	}
+	if avatars.System[0].ID != "1000" {
+		t.Errorf("Expected avatar id 1000, got %s", avatars.System[0].ID)
+	}
+	if avatars.System[0].URLs.Four8X48 == "" {
+		t.Error("Expected 48x48 avatar URL")
+	}
+}
+
+func TestAvatarService_SetAvatar_Project(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/project/EX/avatar")
+
+		var payload Avatar
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.ID != "1000" {
+			t.Errorf("Expected avatar id 1000, got %s", payload.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.SetAvatar(AvatarTypeProject, "EX", "1000"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_SetAvatar_User(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/user/avatar?username=fred%2Bjira%40example.com")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.SetAvatar(AvatarTypeUser, "fred+jira@example.com", "1000"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_SetAvatar_UnknownType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, err := testClient.Avatar.SetAvatar("board", "1", "1000"); err == nil {
+		t.Error("Expected an error for an unknown avatar type")
+	}
+}
@@ -44,9 +44,30 @@ type BoardListOptions struct {
 	SearchOptions
 }
 
-// Wrapper struct for search result
-type sprintsResult struct {
-	Sprints []Sprint `json:"values" structs:"values"`
+// SprintsList reflects a list of sprints of an agile board
+type SprintsList struct {
+	MaxResults int      `json:"maxResults" structs:"maxResults"`
+	StartAt    int      `json:"startAt" structs:"startAt"`
+	IsLast     bool     `json:"isLast" structs:"isLast"`
+	Values     []Sprint `json:"values" structs:"values"`
+}
+
+const (
+	// SprintStateFuture represents a sprint which has not been started yet
+	SprintStateFuture = "future"
+	// SprintStateActive represents a sprint which is currently running
+	SprintStateActive = "active"
+	// SprintStateClosed represents a completed sprint
+	SprintStateClosed = "closed"
+)
+
+// GetAllSprintsOptions specifies the optional parameters to the BoardService.GetAllSprintsWithOptions
+type GetAllSprintsOptions struct {
+	// State filters results to sprints in the specified states (see SprintState* constants).
+	// Multiple states are combined, e.g. future and active sprints.
+	State []string `url:"state,comma,omitempty"`
+
+	SearchOptions
 }
 
 // Sprint represents a sprint on JIRA agile board
@@ -81,6 +102,27 @@ func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Respon
 	return boards, resp, err
 }
 
+// GetBoardsForProject returns all boards relevant to the project projectKeyOrID.
+// Team-managed projects usually have a single board, classic projects may have several.
+// Use Board.Type to tell scrum and kanban boards apart.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getAllBoards
+func (s *BoardService) GetBoardsForProject(projectKeyOrID string) ([]Board, *Response, error) {
+	options := &BoardListOptions{ProjectKeyOrID: projectKeyOrID}
+	var boards []Board
+	for {
+		list, resp, err := s.GetAllBoards(options)
+		if err != nil {
+			return nil, resp, err
+		}
+		boards = append(boards, list.Values...)
+		if list.IsLast || len(list.Values) == 0 {
+			return boards, resp, nil
+		}
+		options.StartAt += len(list.Values)
+	}
+}
+
 // GetBoard will returns the board for the given boardID.
 // This board will only be returned if the user has permission to view it.
 //
@@ -143,13 +185,33 @@ func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
 func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error) {
+	sprints, resp, err := s.GetAllSprintsWithOptions(boardID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sprints.Values, resp, nil
+}
+
+// GetAllSprintsWithOptions returns a page of the sprints of a board, for a given board Id.
+// The sprints can be filtered by their state, e.g. to leave out the closed ones.
+// This only includes sprints that the user has permission to view.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
+func (s *BoardService) GetAllSprintsWithOptions(boardID string, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%s/sprint", boardID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result := new(sprintsResult)
-	resp, err := s.client.Do(req, result)
-	return result.Sprints, resp, err
+	sprints := new(SprintsList)
+	resp, err := s.client.Do(req, sprints)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sprints, resp, nil
 }
@@ -153,6 +153,32 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 	}
 }
 
+func TestBoardService_GetBoardsForProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			testRequestURL(t, r, "/rest/agile/1.0/board?projectKeyOrId=EX")
+			fmt.Fprint(w, `{"maxResults":1,"startAt":0,"isLast":false,"values":[{"id":1,"name":"EX board","type":"scrum"}]}`)
+		case "1":
+			testRequestURL(t, r, "/rest/agile/1.0/board?projectKeyOrId=EX&startAt=1")
+			fmt.Fprint(w, `{"maxResults":1,"startAt":1,"isLast":true,"values":[{"id":2,"name":"EX support","type":"kanban"}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	boards, _, err := testClient.Board.GetBoardsForProject("EX")
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if len(boards) != 2 || boards[0].Type != "scrum" || boards[1].Type != "kanban" {
+		t.Errorf("Unexpected boards %+v", boards)
+	}
+}
+
 func TestBoardService_GetAllSprints(t *testing.T) {
 	setup()
 	defer teardown()
@@ -184,3 +210,30 @@ func TestBoardService_GetAllSprints(t *testing.T) {
 		t.Errorf("Expected 4 transitions. Got %d", len(sprints))
 	}
 }
+
+func TestBoardService_GetAllSprintsWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/123/sprint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/agile/1.0/board/123/sprint?maxResults=10&state=active%2Cfuture")
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"isLast":true,"values":[
+			{"id":2,"self":"https://jira.example.com/rest/agile/1.0/sprint/2","state":"active","name":"Sprint 2","startDate":"2017-08-01T09:00:00.000Z","endDate":"2017-08-15T09:00:00.000Z","originBoardId":123},
+			{"id":3,"self":"https://jira.example.com/rest/agile/1.0/sprint/3","state":"future","name":"Sprint 3","originBoardId":123}
+		]}`)
+	})
+
+	sprints, _, err := testClient.Board.GetAllSprintsWithOptions("123", &GetAllSprintsOptions{
+		State:         []string{SprintStateActive, SprintStateFuture},
+		SearchOptions: SearchOptions{MaxResults: 10},
+	})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if !sprints.IsLast || len(sprints.Values) != 2 {
+		t.Fatalf("Expected the last page with 2 sprints, got %+v", sprints)
+	}
+	if sprints.Values[0].State != SprintStateActive || sprints.Values[0].EndDate == nil || sprints.Values[1].StartDate != nil {
+		t.Errorf("Unexpected sprints %+v", sprints.Values)
+	}
+}
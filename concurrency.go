@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultConcurrency is the number of requests bulk helpers run in parallel unless configured otherwise
+	DefaultConcurrency = 5
+)
+
+var (
+	// rateLimitRetries is the number of times a rate limited request is retried
+	rateLimitRetries = 5
+	// rateLimitBackoff is the initial wait time before retrying a rate limited request.
+	// It is doubled on every retry unless JIRA sends a Retry-After header.
+	rateLimitBackoff = time.Second
+)
+
+// doWithBackoff performs call and retries it with an exponential backoff
+// as long as JIRA responds with 429 Too Many Requests.
+// call has to create a new request on every invocation.
+func (c *Client) doWithBackoff(call func() (*Response, error)) (*Response, error) {
+	backoff := rateLimitBackoff
+	for retry := 0; ; retry++ {
+		resp, err := call()
+		if err == nil || resp == nil || resp.StatusCode != http.StatusTooManyRequests || retry >= rateLimitRetries {
+			return resp, err
+		}
+
+		resp.Body.Close()
+		wait := backoff
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+		c.stats.countRetry(wait)
+		time.Sleep(wait)
+		backoff *= 2
+	}
+}
+
+// runConcurrently calls fn for every index in [0, n) with at most concurrency calls running in parallel.
+// A concurrency below 1 falls back to DefaultConcurrency.
+func runConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = DefaultConcurrency
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// runBulk performs call for every index in [0, n) with at most concurrency calls running in parallel,
+// rate limited calls are retried with a backoff (see doWithBackoff). A concurrency below 1 falls back
+// to DefaultConcurrency, a concurrency of 1 performs the calls in order.
+// A failing call doesn't stop the others, the responses and errors are returned by index.
+func (c *Client) runBulk(n, concurrency int, call func(i int) (*Response, error)) ([]*Response, []error) {
+	responses := make([]*Response, n)
+	errs := make([]error, n)
+	runConcurrently(n, concurrency, func(i int) {
+		responses[i], errs[i] = c.doWithBackoff(func() (*Response, error) {
+			return call(i)
+		})
+	})
+	return responses, errs
+}
@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_doWithBackoff(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"version":"7.4.0"}`))
+	})
+
+	_, err := testClient.doWithBackoff(func() (*Response, error) {
+		_, resp, err := testClient.ServerInfo.Get()
+		return resp, err
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning, done := 0, 0, 0
+	runConcurrently(20, 3, func(i int) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		running--
+		done++
+		mu.Unlock()
+	})
+
+	if done != 20 {
+		t.Errorf("Expected 20 calls, got %d", done)
+	}
+	if maxRunning > 3 {
+		t.Errorf("Expected at most 3 parallel calls, got %d", maxRunning)
+	}
+}
+
+func TestClient_runBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	var order []string
+	limited := false
+	testMux.HandleFunc("/rest/api/2/status/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/rest/api/2/status/")
+		if id == "2" && !limited {
+			limited = true
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		order = append(order, id)
+		if id == "3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%q}`, id)
+	})
+
+	ids := []string{"1", "2", "3", "4"}
+	responses, errs := testClient.runBulk(len(ids), 1, func(i int) (*Response, error) {
+		_, resp, err := testClient.Status.Get(ids[i])
+		return resp, err
+	})
+	if !reflect.DeepEqual(order, ids) {
+		t.Errorf("Expected the calls in order %v, got %v", ids, order)
+	}
+	for i := range ids {
+		if failed := errs[i] != nil; failed != (ids[i] == "3") {
+			t.Errorf("Unexpected error for %s: %v", ids[i], errs[i])
+		}
+		if responses[i] == nil {
+			t.Errorf("Expected a response for %s", ids[i])
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package jira
+
+import (
+	"fmt"
+)
+
+// DashboardService handles dashboards for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/dashboard
+type DashboardService struct {
+	client *Client
+	apiVersion
+}
+
+// DashboardList reflects a paginated list of dashboards
+type DashboardList struct {
+	StartAt    int         `json:"startAt" structs:"startAt"`
+	MaxResults int         `json:"maxResults" structs:"maxResults"`
+	Total      int         `json:"total" structs:"total"`
+	Prev       string      `json:"prev,omitempty" structs:"prev,omitempty"`
+	Next       string      `json:"next,omitempty" structs:"next,omitempty"`
+	Dashboards []Dashboard `json:"dashboards" structs:"dashboards"`
+}
+
+// Dashboard represents a JIRA dashboard
+type Dashboard struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	View string `json:"view,omitempty" structs:"view,omitempty"`
+}
+
+// DashboardListOptions specifies the optional parameters to the DashboardService.GetList
+type DashboardListOptions struct {
+	// Filter restricts the results to the dashboards of a kind.
+	// Valid values: favourite, my.
+	Filter string `url:"filter,omitempty"`
+	// StartAt: The starting index of the returned dashboards. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of dashboards to return per page. Default: 20.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// dashboardGadgetsResult is only a small wrapper around the GetGadgets method
+// to be able to parse the results
+type dashboardGadgetsResult struct {
+	Gadgets []DashboardGadget `json:"gadgets"`
+}
+
+// DashboardGadget represents a single gadget placed on a dashboard
+type DashboardGadget struct {
+	ID        int                     `json:"id" structs:"id"`
+	ModuleKey string                  `json:"moduleKey,omitempty" structs:"moduleKey,omitempty"`
+	URI       string                  `json:"uri,omitempty" structs:"uri,omitempty"`
+	Color     string                  `json:"color,omitempty" structs:"color,omitempty"`
+	Position  DashboardGadgetPosition `json:"position" structs:"position"`
+	Title     string                  `json:"title,omitempty" structs:"title,omitempty"`
+}
+
+// DashboardGadgetPosition represents the position of a gadget in the dashboard layout
+type DashboardGadgetPosition struct {
+	Row    int `json:"row" structs:"row"`
+	Column int `json:"column" structs:"column"`
+}
+
+// GetList returns a paginated list of the dashboards the user has permission to view.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/dashboard-list
+func (s *DashboardService) GetList(opt *DashboardListOptions) (*DashboardList, *Response, error) {
+	apiEndpoint := "rest/api/2/dashboard"
+	url, err := addOptions(apiEndpoint, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboards := new(DashboardList)
+	resp, err := s.client.Do(req, dashboards)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dashboards, resp, nil
+}
+
+// GetGadgets returns the gadgets of the dashboard with the given dashboardID,
+// including their position in the layout.
+// This endpoint is only available in JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-dashboard-dashboardId-gadget-get
+func (s *DashboardService) GetGadgets(dashboardID string) ([]DashboardGadget, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/gadget", dashboardID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(dashboardGadgetsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Gadgets, resp, nil
+}
@@ -0,0 +1,53 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDashboardService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/dashboard"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?filter=favourite")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":20,"total":1,"dashboards":[{"id":"10000","name":"System Dashboard","self":"http://www.example.com/jira/rest/api/2/dashboard/10000","view":"http://www.example.com/jira/secure/Dashboard.jspa?selectPageId=10000"}]}`)
+	})
+
+	dashboards, _, err := testClient.Dashboard.GetList(&DashboardListOptions{Filter: "favourite"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(dashboards.Dashboards) != 1 {
+		t.Errorf("Expected 1 dashboard, got %d", len(dashboards.Dashboards))
+	}
+}
+
+func TestDashboardService_GetGadgets(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/dashboard/10000/gadget"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"gadgets":[{"id":10001,"moduleKey":"com.atlassian.plugins.atlassian-connect-plugin:com.atlassian.connect.node.sample-addon__sample-dashboard-item","color":"blue","position":{"row":0,"column":0},"title":"Issue statistics"},{"id":10002,"uri":"rest/gadgets/1.0/g/com.atlassian.jira.gadgets:bubble-chart-dashboard-item/gadgets/bubble-chart-gadget.xml","color":"red","position":{"row":1,"column":2},"title":"Activity Stream"}]}`)
+	})
+
+	gadgets, _, err := testClient.Dashboard.GetGadgets("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(gadgets) != 2 {
+		t.Fatalf("Expected 2 gadgets, got %d", len(gadgets))
+	}
+	if gadgets[1].Position.Row != 1 || gadgets[1].Position.Column != 2 {
+		t.Errorf("Expected gadget at row 1 column 2, got %+v", gadgets[1].Position)
+	}
+	if gadgets[1].Title != "Activity Stream" {
+		t.Errorf("Expected title Activity Stream, got %s", gadgets[1].Title)
+	}
+}
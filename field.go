@@ -0,0 +1,49 @@
+package jira
+
+// FieldService handles fields for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/field
+type FieldService struct {
+	client *Client
+	apiVersion
+}
+
+// Field represents a field of a JIRA issue, either a system field or a custom field.
+type Field struct {
+	ID          string      `json:"id,omitempty" structs:"id,omitempty"`
+	Key         string      `json:"key,omitempty" structs:"key,omitempty"`
+	Name        string      `json:"name,omitempty" structs:"name,omitempty"`
+	Custom      bool        `json:"custom,omitempty" structs:"custom,omitempty"`
+	Navigable   bool        `json:"navigable,omitempty" structs:"navigable,omitempty"`
+	Searchable  bool        `json:"searchable,omitempty" structs:"searchable,omitempty"`
+	ClauseNames []string    `json:"clauseNames,omitempty" structs:"clauseNames,omitempty"`
+	Schema      FieldSchema `json:"schema,omitempty" structs:"schema,omitempty"`
+}
+
+// FieldSchema describes the type of the value of a field.
+// E.g. Type could be "array" and Items "string" for labels.
+type FieldSchema struct {
+	Type     string `json:"type,omitempty" structs:"type,omitempty"`
+	Items    string `json:"items,omitempty" structs:"items,omitempty"`
+	Custom   string `json:"custom,omitempty" structs:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty" structs:"customId,omitempty"`
+	System   string `json:"system,omitempty" structs:"system,omitempty"`
+}
+
+// GetList gets all fields from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/field-getFields
+func (s *FieldService) GetList() ([]Field, *Response, error) {
+	apiEndpoint := "rest/api/2/field"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fieldList := []Field{}
+	resp, err := s.client.Do(req, &fieldList)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fieldList, resp, nil
+}
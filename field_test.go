@@ -0,0 +1,31 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFieldService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/field"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"id":"description","name":"Description","custom":false,"orderable":true,"navigable":true,"searchable":true,"clauseNames":["description"],"schema":{"type":"string","system":"description"}},
+			{"id":"customfield_10016","key":"customfield_10016","name":"Story Points","custom":true,"orderable":true,"navigable":true,"searchable":true,"clauseNames":["cf[10016]","Story Points"],"schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10016}}]`)
+	})
+
+	fields, _, err := testClient.Field.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if fields[1].Schema.CustomID != 10016 {
+		t.Errorf("Expected custom id 10016, got %d", fields[1].Schema.CustomID)
+	}
+}
@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"fmt"
+)
+
+// FilterService handles saved filters for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/filter
+type FilterService struct {
+	client *Client
+	apiVersion
+}
+
+// Filter represents a saved filter in JIRA
+type Filter struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Owner       *User  `json:"owner,omitempty" structs:"owner,omitempty"`
+	Jql         string `json:"jql,omitempty" structs:"jql,omitempty"`
+	ViewURL     string `json:"viewUrl,omitempty" structs:"viewUrl,omitempty"`
+	SearchURL   string `json:"searchUrl,omitempty" structs:"searchUrl,omitempty"`
+	Favourite   bool   `json:"favourite,omitempty" structs:"favourite,omitempty"`
+}
+
+// FilterSubscription represents a scheduled email subscription of a saved filter.
+// Either User or Group is set, depending on who receives the email.
+type FilterSubscription struct {
+	ID    int                      `json:"id" structs:"id"`
+	User  *User                    `json:"user,omitempty" structs:"user,omitempty"`
+	Group *FilterSubscriptionGroup `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// FilterSubscriptionGroup represents the group receiving a FilterSubscription
+type FilterSubscriptionGroup struct {
+	Name string `json:"name" structs:"name"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+}
+
+// filterSubscriptionsResult is only a small wrapper around GetSubscriptions
+// to be able to parse the results
+type filterSubscriptionsResult struct {
+	Subscriptions struct {
+		Size  int                  `json:"size"`
+		Items []FilterSubscription `json:"items"`
+	} `json:"subscriptions"`
+}
+
+// Get returns the saved filter with the given filterID.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/filter-getFilter
+func (s *FilterService) Get(filterID int) (*Filter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(Filter)
+	resp, err := s.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, err
+	}
+	return filter, resp, nil
+}
+
+// GetIssues runs the JQL of the saved filter with the given filterID and returns the found issues.
+// Paging through the results works the same way as with IssueService.Search.
+//
+// Filters are often shared with users who can't see everything the JQL refers to (e.g. a project).
+// Unless options.ValidateQuery is set, the search is therefore run with ValidateQuery "warn",
+// which returns the issues visible to the current user instead of failing the whole search.
+func (s *FilterService) GetIssues(filterID int, options *SearchOptions) ([]Issue, *Response, error) {
+	filter, resp, err := s.Get(filterID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	searchOptions := SearchOptions{}
+	if options != nil {
+		searchOptions = *options
+	}
+	if searchOptions.ValidateQuery == "" {
+		searchOptions.ValidateQuery = "warn"
+	}
+
+	return s.client.Issue.Search(filter.Jql, &searchOptions)
+}
+
+// GetSubscriptions returns the email subscriptions of the saved filter with the given filterID.
+// The schedule of a subscription is not part of the response.
+//
+// Neither JIRA Server nor JIRA Cloud offer a REST endpoint to create or delete subscriptions,
+// they can only be managed in the JIRA UI.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/filter-getFilter
+func (s *FilterService) GetSubscriptions(filterID int) ([]FilterSubscription, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d?expand=subscriptions", filterID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(filterSubscriptionsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Subscriptions.Items, resp, nil
+}
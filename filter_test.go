@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFilterService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/filter/10000"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/filter/10000","id":"10000","name":"All Open Bugs","description":"Lists all open bugs","owner":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred","name":"fred","displayName":"Fred F. User","active":false},"jql":"type = Bug and resolution is empty","viewUrl":"http://www.example.com/jira/issues/?filter=10000","searchUrl":"http://www.example.com/jira/rest/api/2/search?jql=type%20%3D%20Bug%20and%20resolutino%20is%20empty","favourite":true}`)
+	})
+
+	filter, _, err := testClient.Filter.Get(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter.Jql != "type = Bug and resolution is empty" {
+		t.Errorf("Unexpected JQL %s", filter.Jql)
+	}
+}
+
+func TestFilterService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/filter/10000","id":"10000","name":"All Open Bugs","jql":"type = Bug"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=type+%3D+Bug&startAt=10&maxResults=5&validateQuery=warn")
+		fmt.Fprint(w, `{"expand":"schema,names","startAt":10,"maxResults":5,"total":11,"issues":[{"id":"10230","key":"BULK-62","fields":{"summary":"testing"}}],"warningMessages":["The value 'SECRET' does not exist for the field 'project'."]}`)
+	})
+
+	issues, resp, err := testClient.Filter.GetIssues(10000, &SearchOptions{StartAt: 10, MaxResults: 5})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues))
+	}
+	if resp.Total != 11 {
+		t.Errorf("Expected total 11, got %d", resp.Total)
+	}
+}
+
+func TestFilterService_GetSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/filter/10000?expand=subscriptions")
+		fmt.Fprint(w, `{"id":"10000","name":"All Open Bugs","subscriptions":{"size":2,"items":[{"id":1,"user":{"name":"fred"}},{"id":2,"group":{"name":"jira-developers"}}],"max-results":1000,"start-index":0,"end-index":1}}`)
+	})
+
+	subscriptions, _, err := testClient.Filter.GetSubscriptions(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(subscriptions) != 2 {
+		t.Fatalf("Expected 2 subscriptions, got %d", len(subscriptions))
+	}
+	if subscriptions[0].User == nil || subscriptions[0].User.Name != "fred" {
+		t.Errorf("Expected a subscription of fred, got %+v", subscriptions[0])
+	}
+	if subscriptions[1].Group == nil || subscriptions[1].Group.Name != "jira-developers" {
+		t.Errorf("Expected a subscription of jira-developers, got %+v", subscriptions[1])
+	}
+}
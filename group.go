@@ -2,6 +2,9 @@ package jira
 
 import (
 	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
 )
 
 // GroupService handles Groups for the JIRA instance / API.
@@ -9,6 +12,7 @@ import (
 // JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group
 type GroupService struct {
 	client *Client
+	apiVersion
 }
 
 // groupMembersResult is only a small wrapper around the Group* methods
@@ -38,7 +42,7 @@ type GroupMember struct {
 // JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group-getUsersFromGroup
 func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/group/member?groupname=%s", name)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,3 +55,52 @@ func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
 
 	return group.Members, resp, nil
 }
+
+// GroupFindOptions specifies the optional parameters of GroupService.Find
+type GroupFindOptions struct {
+	// MaxResults: The maximum number of groups to return. Default: 20.
+	MaxResults int `url:"maxResults,omitempty"`
+	// Exclude: Names of groups to leave out of the result, e.g. groups which are already selected.
+	Exclude []string `url:"exclude,omitempty"`
+}
+
+// GroupPickerResult is the result of GroupService.Find
+type GroupPickerResult struct {
+	Header string            `json:"header,omitempty" structs:"header,omitempty"`
+	Total  int               `json:"total" structs:"total"`
+	Groups []GroupSuggestion `json:"groups" structs:"groups"`
+}
+
+// GroupSuggestion reflects a single group suggested by GroupService.Find
+type GroupSuggestion struct {
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	// HTML is the name of the group with the matching part highlighted
+	HTML string `json:"html,omitempty" structs:"html,omitempty"`
+}
+
+// Find returns the groups whose name matches term, e.g. for a group picker.
+// Total is the number of all matching groups, which can be higher than the number of returned groups.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/groups-findGroups
+func (s *GroupService) Find(term string, options *GroupFindOptions) (*GroupPickerResult, *Response, error) {
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, "rest/api/2/groups/picker"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := url.Values{}
+	if options != nil {
+		if q, err = query.Values(options); err != nil {
+			return nil, nil, err
+		}
+	}
+	q.Set("query", term)
+	req.URL.RawQuery = q.Encode()
+
+	result := new(GroupPickerResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
@@ -0,0 +1,28 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGroupService_Find(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/groups/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/groups/picker?exclude=jira-administrators&maxResults=2&query=jira")
+		fmt.Fprint(w, `{"header":"Showing 2 of 3 matching groups","total":3,"groups":[{"name":"jira-developers","html":"<b>jira</b>-developers","labels":[]},{"name":"jira-users","html":"<b>jira</b>-users","labels":[]}]}`)
+	})
+
+	result, _, err := testClient.Group.Find("jira", &GroupFindOptions{MaxResults: 2, Exclude: []string{"jira-administrators"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result.Total != 3 {
+		t.Errorf("Expected total 3, got %d", result.Total)
+	}
+	if len(result.Groups) != 2 || result.Groups[0].Name != "jira-developers" || result.Groups[0].HTML != "<b>jira</b>-developers" {
+		t.Errorf("Expected 2 groups, got %+v", result.Groups)
+	}
+}
@@ -2,15 +2,23 @@ package jira
 
 import (
 	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net/http"
 	"net/url"
+	"path"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/fatih/structs"
 	"github.com/google/go-querystring/query"
@@ -20,6 +28,15 @@ import (
 const (
 	// AssigneeAutomatic represents the value of the "Assignee: Automatic" of JIRA
 	AssigneeAutomatic = "-1"
+
+	// IssueExpandVersionedRepresentations requests all representations of each field value (JIRA Cloud only)
+	IssueExpandVersionedRepresentations = "versionedRepresentations"
+	// IssueExpandSchema requests the schema of each field
+	IssueExpandSchema = "schema"
+	// IssueExpandRenderedFields requests the HTML representation of text fields
+	IssueExpandRenderedFields = "renderedFields"
+	// IssueExpandTransitions requests the transitions the current user can perform on the issue
+	IssueExpandTransitions = "transitions"
 )
 
 // IssueService handles Issues for the JIRA instance / API.
@@ -27,6 +44,7 @@ const (
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue
 type IssueService struct {
 	client *Client
+	apiVersion
 }
 
 // Issue represents a JIRA issue.
@@ -37,6 +55,40 @@ type Issue struct {
 	Key       string       `json:"key,omitempty" structs:"key,omitempty"`
 	Fields    *IssueFields `json:"fields,omitempty" structs:"fields,omitempty"`
 	Changelog *Changelog   `json:"changelog,omitempty" structs:"changelog,omitempty"`
+	// EditMeta is only populated if the issue was requested with Expand "editmeta"
+	EditMeta *EditMetaInfo `json:"editmeta,omitempty" structs:"editmeta,omitempty"`
+	// VersionedRepresentations is only populated if the issue was requested with Expand "versionedRepresentations".
+	// It maps field IDs to the representations of the field value keyed by their version, e.g. "1" and "2".
+	// This expansion is only supported by JIRA Cloud.
+	VersionedRepresentations map[string]map[string]interface{} `json:"versionedRepresentations,omitempty" structs:"versionedRepresentations,omitempty"`
+	// Schema is only populated if the issue was requested with Expand "schema".
+	// It maps field IDs to the schema of their values.
+	Schema map[string]FieldSchema `json:"schema,omitempty" structs:"schema,omitempty"`
+	// RenderedFields is only populated if the issue was requested with Expand "renderedFields"
+	RenderedFields *RenderedFields `json:"renderedFields,omitempty" structs:"renderedFields,omitempty"`
+	// Transitions is only populated if the issue was requested with Expand "transitions".
+	// It saves a separate GetTransitions call.
+	Transitions []Transition `json:"transitions,omitempty" structs:"transitions,omitempty"`
+}
+
+// RenderedFields represents the HTML representation of the text fields of a JIRA issue
+type RenderedFields struct {
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Environment string `json:"environment,omitempty" structs:"environment,omitempty"`
+	// Comment holds the comments of the issue, each with its Body rendered as HTML
+	Comment *Comments `json:"comment,omitempty" structs:"comment,omitempty"`
+}
+
+// CommentBodies maps the ID of each rendered comment to its HTML body.
+func (r *RenderedFields) CommentBodies() map[string]string {
+	bodies := map[string]string{}
+	if r == nil || r.Comment == nil {
+		return bodies
+	}
+	for _, c := range r.Comment.Comments {
+		bodies[c.ID] = c.Body
+	}
+	return bodies
 }
 
 // ChangelogItems reflects one single changelog item of a history item
@@ -59,7 +111,24 @@ type ChangelogHistory struct {
 
 // Changelog reflects the change log of an issue
 type Changelog struct {
-	Histories []ChangelogHistory `json:"histories,omitempty"`
+	StartAt    int                `json:"startAt,omitempty"`
+	MaxResults int                `json:"maxResults,omitempty"`
+	Total      int                `json:"total,omitempty"`
+	Histories  []ChangelogHistory `json:"histories,omitempty"`
+	// Truncated reports if JIRA returned fewer histories than the issue has.
+	// With Expand "changelog" JIRA only returns the most recent histories (usually 100),
+	// the complete history has to be paginated with the changelog endpoint (JIRA Cloud).
+	Truncated bool `json:"-"`
+}
+
+// UnmarshalJSON decodes the change log and detects if it is truncated
+func (c *Changelog) UnmarshalJSON(data []byte) error {
+	type Alias Changelog
+	if err := json.Unmarshal(data, (*Alias)(c)); err != nil {
+		return err
+	}
+	c.Truncated = c.Total > len(c.Histories)
+	return nil
 }
 
 // Attachment represents a JIRA attachment
@@ -90,12 +159,8 @@ type Epic struct {
 // Every JIRA issue has several fields attached.
 type IssueFields struct {
 	// TODO Missing fields
-	//      * "aggregatetimespent": null,
 	//      * "workratio": -1,
 	//      * "lastViewed": null,
-	//      * "aggregatetimeoriginalestimate": null,
-	//      * "aggregatetimeestimate": null,
-	//      * "environment": null,
 	Expand               string        `json:"expand,omitempty" structs:"expand,omitempty"`
 	Type                 IssueType     `json:"issuetype" structs:"issuetype"`
 	Project              Project       `json:"project,omitempty" structs:"project,omitempty"`
@@ -103,11 +168,13 @@ type IssueFields struct {
 	Priority             *Priority     `json:"priority,omitempty" structs:"priority,omitempty"`
 	Resolutiondate       string        `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
 	Created              string        `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate              string        `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	DueDate              Date          `json:"duedate,omitempty" structs:"duedate,omitempty,omitnested"`
 	Watches              *Watches      `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                *Votes        `json:"votes,omitempty" structs:"votes,omitempty"`
 	Assignee             *User         `json:"assignee,omitempty" structs:"assignee,omitempty"`
 	Updated              string        `json:"updated,omitempty" structs:"updated,omitempty"`
 	Description          string        `json:"description,omitempty" structs:"description,omitempty"`
+	Environment          string        `json:"environment,omitempty" structs:"environment,omitempty"`
 	Summary              string        `json:"summary" structs:"summary"`
 	Creator              *User         `json:"Creator,omitempty" structs:"Creator,omitempty"`
 	Reporter             *User         `json:"reporter,omitempty" structs:"reporter,omitempty"`
@@ -116,9 +183,9 @@ type IssueFields struct {
 	Progress             *Progress     `json:"progress,omitempty" structs:"progress,omitempty"`
 	AggregateProgress    *Progress     `json:"aggregateprogress,omitempty" structs:"aggregateprogress,omitempty"`
 	TimeTracking         *TimeTracking `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
-	TimeSpent            int           `json:"timespent,omitempty" structs:"timespent,omitempty"`
-	TimeEstimate         int           `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
-	TimeOriginalEstimate int           `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
+	TimeSpent            *int          `json:"timespent,omitempty" structs:"timespent,omitempty"`
+	TimeEstimate         *int          `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
+	TimeOriginalEstimate *int          `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
 	Worklog              *Worklog      `json:"worklog,omitempty" structs:"worklog,omitempty"`
 	IssueLinks           []*IssueLink  `json:"issuelinks,omitempty" structs:"issuelinks,omitempty"`
 	Comments             *Comments     `json:"comment,omitempty" structs:"comment,omitempty"`
@@ -129,6 +196,18 @@ type IssueFields struct {
 	Epic                 *Epic         `json:"epic,omitempty" structs:"epic,omitempty"`
 	Parent               *Parent       `json:"parent,omitempty" structs:"parent,omitempty"`
 	Unknowns             tcontainer.MarshalMap
+
+	// The time tracking values are in seconds and nil if they are not set in JIRA.
+	// Unlike TimeSpent, TimeEstimate and TimeOriginalEstimate the aggregate values
+	// are summed up over the issue and its subtasks.
+	AggregateTimeSpent            *int `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         *int `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate *int `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
+
+	// Duedate is the due date as "2006-01-02". It is filled when decoding and only sent if DueDate is not set.
+	//
+	// Deprecated: Use DueDate instead.
+	Duedate string `json:"-" structs:"-"`
 }
 
 type DeleteIssueOptions struct {
@@ -139,6 +218,9 @@ type DeleteIssueOptions struct {
 // It handles JIRA custom fields and maps those from / to "Unknowns" key.
 func (i *IssueFields) MarshalJSON() ([]byte, error) {
 	m := structs.Map(i)
+	if time.Time(i.DueDate).IsZero() && i.Duedate != "" {
+		m["duedate"] = i.Duedate
+	}
 	unknowns, okay := m["Unknowns"]
 	if okay {
 		// if unknowns present, shift all key value from unkown to a level up
@@ -165,6 +247,9 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	if due := time.Time(i.DueDate); !due.IsZero() {
+		i.Duedate = due.Format("2006-01-02")
+	}
 
 	totalMap := tcontainer.NewMarshalMap()
 	err := json.Unmarshal(data, &totalMap)
@@ -199,6 +284,42 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 
 }
 
+// TimeTrackingSeconds contains the time tracking values of an issue in seconds.
+// A nil value means that the value is not set in JIRA.
+type TimeTrackingSeconds struct {
+	TimeSpent         *int
+	RemainingEstimate *int
+	OriginalEstimate  *int
+}
+
+// GetTimeTracking returns the time tracking values of the issue itself (raw)
+// and the values summed up over the issue and its subtasks (aggregate).
+func (i *IssueFields) GetTimeTracking() (raw, aggregate TimeTrackingSeconds) {
+	raw = TimeTrackingSeconds{
+		TimeSpent:         i.TimeSpent,
+		RemainingEstimate: i.TimeEstimate,
+		OriginalEstimate:  i.TimeOriginalEstimate,
+	}
+	aggregate = TimeTrackingSeconds{
+		TimeSpent:         i.AggregateTimeSpent,
+		RemainingEstimate: i.AggregateTimeEstimate,
+		OriginalEstimate:  i.AggregateTimeOriginalEstimate,
+	}
+	return raw, aggregate
+}
+
+// GetVersionedRepresentation returns the representation of the field fieldID in the given version.
+// It requires the issue to be requested with Expand "versionedRepresentations" (JIRA Cloud only).
+// The second return value reports if the representation exists.
+func (i *Issue) GetVersionedRepresentation(fieldID string, version int) (interface{}, bool) {
+	versions, ok := i.VersionedRepresentations[fieldID]
+	if !ok {
+		return nil, false
+	}
+	value, ok := versions[strconv.Itoa(version)]
+	return value, ok
+}
+
 // IssueType represents a type of a JIRA issue.
 // Typical types are "Request", "Bug", "Story", ...
 type IssueType struct {
@@ -234,6 +355,15 @@ type Watches struct {
 	Self       string `json:"self,omitempty" structs:"self,omitempty"`
 	WatchCount int    `json:"watchCount,omitempty" structs:"watchCount,omitempty"`
 	IsWatching bool   `json:"isWatching,omitempty" structs:"isWatching,omitempty"`
+	// Watchers is only populated by IssueService.GetWatchers
+	Watchers []User `json:"watchers,omitempty" structs:"watchers,omitempty"`
+}
+
+// Votes represents how many users voted for a JIRA issue.
+type Votes struct {
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int    `json:"votes,omitempty" structs:"votes,omitempty"`
+	HasVoted bool   `json:"hasVoted,omitempty" structs:"hasVoted,omitempty"`
 }
 
 // AvatarUrls represents different dimensions of avatars / images
@@ -275,9 +405,12 @@ type StatusCategory struct {
 }
 
 // Progress represents the progress of a JIRA issue.
+// Progress and Total are the time spent and the total estimated time in seconds.
+// Percent is only set by JIRA if Total is not 0.
 type Progress struct {
 	Progress int `json:"progress" structs:"progress"`
 	Total    int `json:"total" structs:"total"`
+	Percent  int `json:"percent,omitempty" structs:"percent,omitempty"`
 }
 
 // Parent represents the parent of a JIRA issue, to be used with subtask issue types.
@@ -289,6 +422,10 @@ type Parent struct {
 // Time represents the Time definition of JIRA as a time.Time of go
 type Time time.Time
 
+// Date represents the Date definition of JIRA as a time.Time of go.
+// JIRA dates (e.g. the due date of an issue) don't have a time of day, they are formatted "2006-01-02".
+type Date time.Time
+
 // Wrapper struct for search result
 type transitionResult struct {
 	Transitions []Transition `json:"transitions" structs:"transitions"`
@@ -298,6 +435,7 @@ type transitionResult struct {
 type Transition struct {
 	ID     string                     `json:"id" structs:"id"`
 	Name   string                     `json:"name" structs:"name"`
+	To     Status                     `json:"to" structs:"to"`
 	Fields map[string]TransitionField `json:"fields" structs:"fields"`
 }
 
@@ -309,6 +447,35 @@ type TransitionField struct {
 // CreateTransitionPayload is used for creating new issue transitions
 type CreateTransitionPayload struct {
 	Transition TransitionPayload `json:"transition" structs:"transition"`
+	// Properties are set on the issue together with the transition
+	Properties []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
+	// Fields are set on the issue together with the transition, e.g. the resolution
+	Fields map[string]interface{} `json:"fields,omitempty" structs:"fields,omitempty"`
+	// Update contains the operations applied to the issue together with the transition
+	Update *TransitionUpdate `json:"update,omitempty" structs:"update,omitempty"`
+}
+
+// TransitionUpdate represents the update operations of an issue transition
+type TransitionUpdate struct {
+	Comment []TransitionCommentOperation `json:"comment,omitempty" structs:"comment,omitempty"`
+}
+
+// TransitionCommentOperation adds a comment to the issue during a transition
+type TransitionCommentOperation struct {
+	Add *TransitionComment `json:"add" structs:"add"`
+}
+
+// TransitionComment is a comment added to an issue during a transition
+type TransitionComment struct {
+	Body       string             `json:"body" structs:"body"`
+	Visibility *CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+}
+
+// EntityProperty represents an entity property (e.g. of an issue) in JIRA.
+// The value can be any JSON value.
+type EntityProperty struct {
+	Key   string      `json:"key" structs:"key"`
+	Value interface{} `json:"value" structs:"value"`
 }
 
 // TransitionPayload represents the request payload of Transistion calls like DoTransition
@@ -327,6 +494,27 @@ func (t *Time) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON will transform the Date object into a short
+// date string as JIRA expects during the creation of a
+// JIRA request
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(time.Time(d).Format("\"2006-01-02\"")), nil
+}
+
+// UnmarshalJSON will transform the JIRA date into a time.Time
+// during the transformation of the JIRA JSON response
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	ti, err := time.Parse("\"2006-01-02\"", string(b))
+	if err != nil {
+		return err
+	}
+	*d = Date(ti)
+	return nil
+}
+
 // Worklog represents the work log of a JIRA issue.
 // One Worklog contains zero or n WorklogRecords
 // JIRA Wiki: https://confluence.atlassian.com/jira/logging-work-on-an-issue-185729605.html
@@ -440,6 +628,84 @@ type SearchOptions struct {
 	MaxResults int `url:"maxResults,omitempty"`
 	// Expand: Expand specific sections in the returned issues
 	Expand string `url:"expand,omitempty"`
+	// ValidateQuery: Whether to validate the JQL query and how strictly. Valid values: strict, warn, none. Default: strict.
+	// With "warn" or "none", clauses referring to values the user can't see don't fail the search.
+	// Only used by the JQL search.
+	ValidateQuery string `url:"validateQuery,omitempty"`
+	// Fields: The list of fields to return for each issue. Supports the same selectors as GetQueryOptions.Fields.
+	// Only used by the JQL search. Default: all navigable fields.
+	Fields []string `url:"fields,comma,omitempty"`
+	// OrderBy: The sort order of the returned issues. It replaces any ORDER BY clause of the JQL.
+	// Only used by the JQL search.
+	OrderBy []OrderClause `url:"-"`
+}
+
+// OrderClause represents one sort key of an ORDER BY clause in JQL.
+// Field is a field name (e.g. "Story Points"), a field ID (e.g. "customfield_10016") or a JQL reference (e.g. "cf[10016]").
+type OrderClause struct {
+	Field string
+	Asc   bool
+}
+
+var (
+	orderByPattern     = regexp.MustCompile(`(?is)^ORDER\s+BY\s`)
+	customFieldPattern = regexp.MustCompile(`^customfield_(\d+)$`)
+	jqlFieldPattern    = regexp.MustCompile(`^([A-Za-z0-9_]+|cf\[\d+\])$`)
+)
+
+// orderByJQL replaces the ORDER BY clause of jql by one built from clauses.
+// Custom field IDs are referenced as cf[id], field names which aren't plain words are quoted.
+func orderByJQL(jql string, clauses []OrderClause) string {
+	jql = strings.TrimSpace(withoutOrderBy(jql))
+
+	keys := make([]string, len(clauses))
+	for i, clause := range clauses {
+		field := clause.Field
+		if m := customFieldPattern.FindStringSubmatch(field); m != nil {
+			field = fmt.Sprintf("cf[%s]", m[1])
+		} else if !jqlFieldPattern.MatchString(field) {
+			field = quoteJQL(field)
+		}
+
+		direction := "DESC"
+		if clause.Asc {
+			direction = "ASC"
+		}
+		keys[i] = field + " " + direction
+	}
+
+	orderBy := "ORDER BY " + strings.Join(keys, ", ")
+	if jql == "" {
+		return orderBy
+	}
+	return jql + " " + orderBy
+}
+
+// withoutOrderBy removes the ORDER BY clause of jql.
+// An "order by" inside a quoted string (e.g. summary ~ "fix order by") is not a clause and kept.
+func withoutOrderBy(jql string) string {
+	var quote byte
+	for i := 0; i < len(jql); i++ {
+		c := jql[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case (i == 0 || unicode.IsSpace(rune(jql[i-1]))) && orderByPattern.MatchString(jql[i:]):
+			return jql[:i]
+		}
+	}
+	return jql
+}
+
+// quoteJQL quotes value as a JQL string, only " and \ are escaped.
+func quoteJQL(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
 }
 
 // searchResult is only a small wrapper around the Search (with JQL) method
@@ -451,9 +717,20 @@ type searchResult struct {
 	Total      int     `json:"total" structs:"total"`
 }
 
+// typedSearchResult is the counterpart of searchResult used by SearchInto.
+// Issues holds the pointer passed by the caller.
+type typedSearchResult struct {
+	Issues     interface{} `json:"issues"`
+	StartAt    int         `json:"startAt"`
+	MaxResults int         `json:"maxResults"`
+	Total      int         `json:"total"`
+}
+
 // GetQueryOptions specifies the optional parameters for the Get Issue methods
 type GetQueryOptions struct {
 	// Fields is the list of fields to return for the issue. By default, all fields are returned.
+	// Besides field ids, JIRA supports the selectors "*all" and "*navigable" and excluding fields with a leading "-",
+	// e.g. "*all,-comment". The value is passed to JIRA as is.
 	Fields string `url:"fields,omitempty"`
 	Expand string `url:"expand,omitempty"`
 	// Properties is the list of properties to return for the issue. By default no properties are returned.
@@ -477,7 +754,7 @@ type CustomFields map[string]string
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
 func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -499,13 +776,119 @@ func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *R
 	return issue, resp, nil
 }
 
+// Permission represents a permission of the current user in JIRA
+type Permission struct {
+	ID             string `json:"id,omitempty" structs:"id,omitempty"`
+	Key            string `json:"key,omitempty" structs:"key,omitempty"`
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+	Type           string `json:"type,omitempty" structs:"type,omitempty"`
+	Description    string `json:"description,omitempty" structs:"description,omitempty"`
+	HavePermission bool   `json:"havePermission" structs:"havePermission"`
+}
+
+// IssueDetails bundles everything required to show the detail view of an issue
+type IssueDetails struct {
+	Issue    *Issue
+	Comments []*Comment
+	Worklogs []WorklogRecord
+	Watchers []User
+	// Permissions are the permissions of the current user for the issue, keyed by permission key
+	Permissions map[string]Permission
+}
+
+// Exists reports if the issue with the given issueID exists and is visible to the current user,
+// without fetching the issue itself.
+// If false is returned, the status code of the response tells the reason:
+// http.StatusNotFound if the issue doesn't exist (JIRA also uses it for issues the user can't browse)
+// and http.StatusForbidden if the user has no permission to see it.
+// Other failures are returned as error.
+func (s *IssueService) Exists(issueID string) (bool, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s?fields=key", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return false, resp, nil
+		}
+		return false, resp, err
+	}
+	resp.Body.Close()
+	return true, resp, nil
+}
+
+// GetDetails fetches an issue together with its comments, worklogs, watchers and
+// the permissions of the current user for it. The requests run concurrently.
+// If one of the requests fails because of missing authentication or permissions,
+// the remaining requests are cancelled and that error is returned.
+// Cancelling ctx cancels all outstanding requests. options are used to get the issue itself.
+func (s *IssueService) GetDetails(ctx context.Context, issueID string, options *GetQueryOptions) (*IssueDetails, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	issueURL, err := addOptions(fmt.Sprintf("rest/api/2/issue/%s", issueID), options)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &IssueDetails{Issue: new(Issue)}
+	comments := new(Comments)
+	worklog := new(Worklog)
+	watches := new(Watches)
+	permissions := new(struct {
+		Permissions map[string]Permission `json:"permissions"`
+	})
+	requests := []struct {
+		apiEndpoint string
+		v           interface{}
+	}{
+		{issueURL, details.Issue},
+		{fmt.Sprintf("rest/api/2/issue/%s/comment", issueID), comments},
+		{fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID), worklog},
+		{fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID), watches},
+		{fmt.Sprintf("rest/api/2/mypermissions?issueKey=%s", url.QueryEscape(issueID)), permissions},
+	}
+
+	errs := make([]error, len(requests))
+	authErrs := make([]error, len(requests))
+	runConcurrently(len(requests), DefaultConcurrency, func(i int) {
+		req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, requests[i].apiEndpoint), nil)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		resp, err := s.client.Do(req.WithContext(ctx), requests[i].v)
+		if err != nil && resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			authErrs[i] = err
+			cancel()
+		}
+		errs[i] = err
+	})
+
+	// Prefer authentication errors over the errors of the requests cancelled because of them
+	for _, err := range append(authErrs, errs...) {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	details.Comments = comments.Comments
+	details.Worklogs = worklog.Worklogs
+	details.Watchers = watches.Watchers
+	details.Permissions = permissions.Permissions
+	return details, nil
+}
+
 // DownloadAttachment returns a Response of an attachment for a given attachmentID.
 // The attachment is in the Response.Body of the response.
 // This is an io.ReadCloser.
 // The caller should close the resp.Body.
 func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("secure/attachment/%s/", attachmentID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +921,7 @@ func (s *IssueService) PostAttachment(attachmentID string, r io.Reader, attachme
 	}
 	writer.Close()
 
-	req, err := s.client.NewMultiPartRequest("POST", apiEndpoint, b)
+	req, err := s.client.NewMultiPartRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), b)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -562,7 +945,7 @@ func (s *IssueService) PostAttachment(attachmentID string, r io.Reader, attachme
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-createIssues
 func (s *IssueService) Create(issue *Issue) (*Issue, *Response, error) {
 	apiEndpoint := "rest/api/2/issue/"
-	req, err := s.client.NewRequest("POST", apiEndpoint, issue)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), issue)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -585,131 +968,1160 @@ func (s *IssueService) Create(issue *Issue) (*Issue, *Response, error) {
 	return responseIssue, resp, nil
 }
 
+// IssueTemplate represents a predefined shape of an issue, e.g. a standard bug report.
+type IssueTemplate struct {
+	ProjectKey string
+	IssueType  string
+	// Fields are the default values of the issue fields keyed by field ID, e.g. "summary" or "customfield_10000".
+	// The values are sent to JIRA as they are, so they have to be in the format the create issue endpoint expects.
+	// String values may contain placeholders like "{{component}}", see IssueService.CreateFromTemplate.
+	Fields map[string]interface{}
+}
+
+// placeholderPattern matches the placeholders of an IssueTemplate
+var placeholderPattern = regexp.MustCompile(`{{\s*([^{}\s]+)\s*}}`)
+
+// CreateFromTemplate creates an issue from template.
+// Placeholders of the form "{{name}}" in string values of the template are replaced with the override of the
+// same name, unknown placeholders are left untouched. All other overrides replace or add field values.
+// Before the issue is created, the fields are validated against the required fields of the create meta
+// information of the project and issue type, fields with a default value in JIRA are not required.
+func (s *IssueService) CreateFromTemplate(template *IssueTemplate, overrides map[string]interface{}) (*Issue, *Response, error) {
+	fields := map[string]interface{}{}
+	placeholders := map[string]bool{}
+	for id, value := range template.Fields {
+		fields[id] = value
+		if text, ok := value.(string); ok {
+			for _, m := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+				placeholders[m[1]] = true
+			}
+		}
+	}
+	for id, value := range overrides {
+		if _, isField := template.Fields[id]; isField || !placeholders[id] {
+			fields[id] = value
+		}
+	}
+	for id, value := range fields {
+		if text, ok := value.(string); ok {
+			fields[id] = placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+				name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+				if override, ok := overrides[name]; ok {
+					return fmt.Sprint(override)
+				}
+				return placeholder
+			})
+		}
+	}
+	fields["project"] = map[string]string{"key": template.ProjectKey}
+	fields["issuetype"] = map[string]string{"name": template.IssueType}
+
+	meta, resp, err := s.GetCreateMeta(template.ProjectKey)
+	if err != nil {
+		return nil, resp, err
+	}
+	project := meta.GetProjectWithKey(template.ProjectKey)
+	if project == nil {
+		return nil, resp, fmt.Errorf("Project %s not found in the create meta information", template.ProjectKey)
+	}
+	issueType := project.GetIssueTypeWithName(template.IssueType)
+	if issueType == nil {
+		return nil, resp, fmt.Errorf("Issue type %s not found in project %s", template.IssueType, template.ProjectKey)
+	}
+	mandatory, err := issueType.GetMandatoryFields()
+	if err != nil {
+		return nil, resp, err
+	}
+	var missing []string
+	for name, id := range mandatory {
+		if hasDefault, _ := issueType.Fields.Bool(id + "/hasDefaultValue"); hasDefault {
+			continue
+		}
+		if _, ok := fields[id]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, resp, fmt.Errorf("Required fields are missing: %s", strings.Join(missing, ", "))
+	}
+
+	apiEndpoint := "rest/api/2/issue/"
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), map[string]interface{}{"fields": fields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(Issue)
+	resp, err = s.client.Do(req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// UpdateIssue updates the fields of an issue from a JSON representation, e.g. {"fields": {"summary": "..."}}.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) UpdateIssue(issueID string, data map[string]interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+	req, err := s.client.NewRequest("PUT", s.client.apiEndpoint(s.APIVersion, apiEndpoint), data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// Flag marks the issue as impeded by setting the "Flagged" field used by agile boards.
+// The field is discovered via MetadataService.Bootstrap, an error is returned if it isn't configured.
+func (s *IssueService) Flag(issueID string) (*Response, error) {
+	return s.setFlagged(issueID, []map[string]string{{"value": "Impediment"}})
+}
+
+// Unflag clears the "Flagged" field of the issue, see Flag.
+func (s *IssueService) Unflag(issueID string) (*Response, error) {
+	return s.setFlagged(issueID, []map[string]string{})
+}
+
+// setFlagged sets the value of the "Flagged" field of the issue
+func (s *IssueService) setFlagged(issueID string, value []map[string]string) (*Response, error) {
+	metadata, err := s.client.Metadata.Bootstrap()
+	if err != nil {
+		return nil, err
+	}
+
+	fieldID := ""
+	for _, field := range metadata.Fields {
+		if field.Name == "Flagged" && field.Schema.Custom == "com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes" {
+			fieldID = field.ID
+			break
+		}
+	}
+	if fieldID == "" {
+		return nil, fmt.Errorf("The \"Flagged\" field is not configured in this JIRA instance")
+	}
+
+	data := map[string]interface{}{
+		"fields": map[string]interface{}{
+			fieldID: value,
+		},
+	}
+	return s.UpdateIssue(issueID, data)
+}
+
 // Delete an existing issue.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-deleteIssue
 func (s *IssueService) Delete(issueID string, deleteSubTasks bool) (*Response, error) {
+	_, resp, err := s.DeleteWithOptions(issueID, &DeleteOptions{DeleteSubtasks: deleteSubTasks})
+	return resp, err
+}
+
+// DeleteOptions specifies the optional parameters of IssueService.DeleteWithOptions
+type DeleteOptions struct {
+	// DeleteSubtasks deletes the subtasks of the issue as well. JIRA refuses to delete issues with subtasks otherwise.
+	DeleteSubtasks bool
+	// Snapshot fetches the key, summary and status of the issue right before deleting it,
+	// e.g. to keep an audit record of what was deleted.
+	Snapshot bool
+}
+
+// DeleteWithOptions deletes an existing issue.
+// If options.Snapshot is set, the issue as it was right before the deletion is returned.
+// If the snapshot can't be fetched, the issue is not deleted.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-deleteIssue
+func (s *IssueService) DeleteWithOptions(issueID string, options *DeleteOptions) (*Issue, *Response, error) {
+	if options == nil {
+		options = &DeleteOptions{}
+	}
+
+	var snapshot *Issue
+	if options.Snapshot {
+		issue, resp, err := s.Get(issueID, &GetQueryOptions{Fields: "summary,status"})
+		if err != nil {
+			return nil, resp, err
+		}
+		snapshot = issue
+	}
+
 	var err error
 	url := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	if deleteSubTasks {
+	if options.DeleteSubtasks {
 		opts := DeleteIssueOptions{DeleteSubtasks: "true"}
 		url, err = addOptions(url, &opts)
 		if err != nil {
 			// incase of error return the resp for further inspection
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	req, err := s.client.NewRequest("DELETE", url, nil)
+	req, err := s.client.NewRequest("DELETE", s.client.apiEndpoint(s.APIVersion, url), nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
 		// incase of error return the resp for further inspection
-		return resp, err
+		return nil, resp, err
 	}
 
-	return resp, nil
+	return snapshot, resp, nil
 }
 
-// AddComment adds a new comment to issueID.
+// GetProperty returns the property with the given propertyKey of an issue.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
-func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
-	req, err := s.client.NewRequest("POST", apiEndpoint, comment)
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (s *IssueService) GetProperty(issueID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/properties/%s", issueID, propertyKey)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	responseComment := new(Comment)
-	resp, err := s.client.Do(req, responseComment)
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
 	if err != nil {
 		return nil, resp, err
 	}
+	return property, resp, nil
+}
 
-	return responseComment, resp, nil
+// BulkPropertyDeleteFilter selects the issues an issue property is deleted from by IssueService.DeletePropertyBulk.
+// Only issues matching all given criteria are changed.
+type BulkPropertyDeleteFilter struct {
+	// EntityIds restricts the deletion to the issues with the given ids
+	EntityIds []int64 `json:"entityIds,omitempty" structs:"entityIds,omitempty"`
+	// CurrentValue restricts the deletion to the issues where the property has this value
+	CurrentValue interface{} `json:"currentValue,omitempty" structs:"currentValue,omitempty"`
 }
 
-// AddLink adds a link between two issues.
+// DeletePropertyBulk deletes the issue property with the given propertyKey from all issues matching filter.
+// The deletion runs asynchronously in JIRA.
+// The returned location is the URL of the task, its last path segment is the id to poll with TaskService.Get.
+// In a dry run (see Client.DryRun) the returned location is empty.
+// The endpoint doesn't accept a JQL query, so search for the issues first to delete a property from the issues matching a JQL.
+// This endpoint is only available in JIRA Cloud.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
-func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink")
-	req, err := s.client.NewRequest("POST", apiEndpoint, issueLink)
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-properties-propertyKey-delete
+func (s *IssueService) DeletePropertyBulk(propertyKey string, filter *BulkPropertyDeleteFilter) (string, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/properties/%s", propertyKey)
+	req, err := s.client.NewRequest("DELETE", s.client.apiEndpoint(s.APIVersion, apiEndpoint), filter)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
-	return resp, err
+	if err != nil {
+		return "", resp, err
+	}
+	if resp.DryRun {
+		return "", resp, nil
+	}
+
+	// JIRA answers with "303 See Other" to the task.
+	// If the http.Client followed the redirect, the task is the final request URL.
+	location := resp.Header.Get("Location")
+	if location == "" && resp.Request != nil {
+		location = resp.Request.URL.String()
+	}
+	return location, resp, nil
 }
 
-// Search will search for tickets according to the jql
+// ArchiveByJQL archives all issues matching jql.
+// The archiving runs asynchronously in JIRA, the returned task id can be polled with TaskService.Get.
+// In a dry run (see Client.DryRun) the returned task id is empty.
+// JIRA doesn't report how many issues were queued, search with MaxResults 0 beforehand to learn the total.
+// Archiving by JQL is only available on JIRA Data Center.
 //
-// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
-func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
-	var u string
-	if options == nil {
-		u = fmt.Sprintf("rest/api/2/search?jql=%s", url.QueryEscape(jql))
-	} else {
-		u = fmt.Sprintf("rest/api/2/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql),
-			options.StartAt, options.MaxResults)
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue-archiveIssuesAsync
+func (s *IssueService) ArchiveByJQL(jql string) (string, *Response, error) {
+	cloud, err := s.client.ServerInfo.IsCloud()
+	if err != nil {
+		return "", nil, err
+	}
+	if cloud {
+		return "", nil, fmt.Errorf("Archiving issues by JQL is not supported on JIRA Cloud, it requires JIRA Data Center")
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, "rest/api/2/issue/archive"), jql)
 	if err != nil {
-		return []Issue{}, nil, err
+		return "", nil, err
 	}
 
-	v := new(searchResult)
-	resp, err := s.client.Do(req, v)
-	return v.Issues, resp, err
+	// JIRA answers with the URL of the task
+	var location string
+	resp, err := s.client.Do(req, &location)
+	if err != nil {
+		return "", resp, err
+	}
+	if resp.DryRun {
+		return "", resp, nil
+	}
+	location = strings.TrimRight(location, "/")
+	if location == "" {
+		return "", resp, fmt.Errorf("JIRA didn't return the task archiving the issues")
+	}
+	return path.Base(location), resp, nil
 }
 
-// GetCustomFields returns a map of customfield_* keys with string values
-func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+// AddComment adds a new comment to issueID.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
+func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), comment)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	issue := new(map[string]interface{})
-	resp, err := s.client.Do(req, issue)
+	responseComment := new(Comment)
+	resp, err := s.client.Do(req, responseComment)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	m := *issue
-	f := m["fields"]
-	cf := make(CustomFields)
-	if f == nil {
-		return cf, resp, nil
-	}
+	return responseComment, resp, nil
+}
 
-	if rec, ok := f.(map[string]interface{}); ok {
-		for key, val := range rec {
-			if strings.Contains(key, "customfield") {
-				if valMap, ok := val.(map[string]interface{}); ok {
-					if v, ok := valMap["value"]; ok {
-						val = v
-					}
-				}
-				cf[key] = fmt.Sprint(val)
-			}
-		}
-	}
-	return cf, resp, nil
+// CommentResult represents the outcome of adding a single comment with IssueService.AddComments
+// or IssueService.AddCommentBulk. Either Comment or Error is set.
+type CommentResult struct {
+	IssueKey string
+	Comment  *Comment
+	Response *Response
+	Error    error
 }
 
-// GetTransitions gets a list of the transitions possible for this issue by the current user,
-// along with fields that are required and their types.
+// AddComments adds the comments to issueID one after another, preserving their order.
+// JIRA has no bulk endpoint for comments, so every comment is a separate request.
+// The results are returned in the order of comments.
+//
+// The Author and Created of the comments are sent along, but JIRA only keeps them for users allowed
+// to import data (e.g. in import mode with the respective permission). Otherwise JIRA sets the
+// current user and time, check the returned comments if that matters.
+func (s *IssueService) AddComments(issueID string, comments []*Comment) []CommentResult {
+	results := make([]CommentResult, len(comments))
+	responses, errs := s.client.runBulk(len(comments), 1, func(i int) (*Response, error) {
+		var resp *Response
+		var err error
+		results[i].Comment, resp, err = s.AddComment(issueID, comments[i])
+		return resp, err
+	})
+	for i := range results {
+		results[i].IssueKey = issueID
+		results[i].Response, results[i].Error = responses[i], errs[i]
+	}
+	return results
+}
+
+// AddCommentBulk adds the same comment to all issues, commenting on up to concurrency issues at once,
+// e.g. to post a maintenance notice to all issues of a sprint. The visibility of the comment is respected.
+// The results are returned in the order of issueKeys, the issues the comment couldn't be added to have an Error.
+func (s *IssueService) AddCommentBulk(issueKeys []string, comment *Comment, concurrency int) []CommentResult {
+	results := make([]CommentResult, len(issueKeys))
+	responses, errs := s.client.runBulk(len(issueKeys), concurrency, func(i int) (*Response, error) {
+		var resp *Response
+		var err error
+		results[i].Comment, resp, err = s.AddComment(issueKeys[i], comment)
+		return resp, err
+	})
+	for i := range results {
+		results[i].IssueKey = issueKeys[i]
+		results[i].Response, results[i].Error = responses[i], errs[i]
+	}
+	return results
+}
+
+// GetCommentCount returns the number of comments of an issue without fetching the comments themselves.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getComments
+func (s *IssueService) GetCommentCount(issueID string) (int, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment?maxResults=0", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := new(struct {
+		Total int `json:"total"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return 0, resp, err
+	}
+	return result.Total, resp, nil
+}
+
+// SubtaskProgress represents the completion of the subtasks of an issue
+type SubtaskProgress struct {
+	Done  int
+	Total int
+	// Incomplete contains the keys of all subtasks which are not done yet
+	Incomplete []string
+}
+
+// GetSubtaskProgress counts how many subtasks of the issue parentKey are done.
+// A subtask is done if its status belongs to the status category "done",
+// so the result does not depend on the status names of a workflow.
+func (s *IssueService) GetSubtaskProgress(parentKey string) (*SubtaskProgress, *Response, error) {
+	issue, resp, err := s.Get(parentKey, &GetQueryOptions{Fields: "subtasks"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	progress := &SubtaskProgress{Incomplete: []string{}}
+	if issue.Fields == nil {
+		return progress, resp, nil
+	}
+	for _, subtask := range issue.Fields.Subtasks {
+		progress.Total++
+		if subtask.Fields.Status != nil && subtask.Fields.Status.StatusCategory.Key == "done" {
+			progress.Done++
+		} else {
+			progress.Incomplete = append(progress.Incomplete, subtask.Key)
+		}
+	}
+	return progress, resp, nil
+}
+
+// GetWorklogOptions specifies the optional parameters of IssueService.GetWorklog
+type GetWorklogOptions struct {
+	// Expand: Expand specific sections of the worklog, e.g. "renderedBody" or "properties"
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetWorklog returns a single worklog entry of an issue.
+// If the worklog doesn't exist (anymore) a descriptive error is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getWorklog
+func (s *IssueService) GetWorklog(issueID, worklogID string, options *GetWorklogOptions) (*WorklogRecord, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	worklog := new(WorklogRecord)
+	resp, err := s.client.Do(req, worklog)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, resp, fmt.Errorf("Worklog %s of issue %s does not exist or was deleted. Status code: %d", worklogID, issueID, resp.StatusCode)
+		}
+		return nil, resp, err
+	}
+	return worklog, resp, nil
+}
+
+// GetWatchersOptions specifies the optional parameters of IssueService.GetWatchers
+type GetWatchersOptions struct {
+	// HydrateUsers fetches the full user (display name, avatars, ...) of every watcher
+	// which is only returned with its name. This requires one request per such watcher.
+	HydrateUsers bool
+}
+
+// GetWatchers returns the users watching the issue.
+// Depending on the JIRA version and the permissions of the current user, watchers are
+// returned as full users or only with their name. Use options.HydrateUsers to fetch
+// the full users in the latter case.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) GetWatchers(issueID string, options *GetWatchersOptions) ([]User, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if options == nil || !options.HydrateUsers {
+		return watches.Watchers, resp, nil
+	}
+
+	watchers := watches.Watchers
+	errs := make([]error, len(watchers))
+	runConcurrently(len(watchers), DefaultConcurrency, func(i int) {
+		if watchers[i].DisplayName != "" || watchers[i].Name == "" {
+			return
+		}
+		user, _, err := s.client.User.Get(watchers[i].Name)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		watchers[i] = *user
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+	return watchers, resp, nil
+}
+
+// IsWatching reports if the current user is watching the issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) IsWatching(issueID string) (bool, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return false, resp, err
+	}
+	return watches.IsWatching, resp, nil
+}
+
+// AddLink adds a link between two issues.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
+func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink")
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), issueLink)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	return resp, err
+}
+
+// RemoteLink represents a link from a JIRA issue to an object outside of JIRA, e.g. a web page.
+type RemoteLink struct {
+	ID           int                    `json:"id,omitempty" structs:"id,omitempty"`
+	Self         string                 `json:"self,omitempty" structs:"self,omitempty"`
+	GlobalID     string                 `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty" structs:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty" structs:"relationship,omitempty"`
+	Object       *RemoteLinkObject      `json:"object" structs:"object"`
+}
+
+// RemoteLinkApplication represents the application a RemoteLink points to
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// RemoteLinkObject represents the object a RemoteLink points to
+type RemoteLinkObject struct {
+	URL     string          `json:"url" structs:"url"`
+	Title   string          `json:"title" structs:"title"`
+	Summary string          `json:"summary,omitempty" structs:"summary,omitempty"`
+	Icon    *RemoteLinkIcon `json:"icon,omitempty" structs:"icon,omitempty"`
+}
+
+// RemoteLinkIcon represents the icon of a RemoteLinkObject
+type RemoteLinkIcon struct {
+	URL16x16 string `json:"url16x16,omitempty" structs:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty" structs:"title,omitempty"`
+}
+
+// GetRemoteLinks returns the remote links of an issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue/{issueIdOrKey}/remotelink-getRemoteIssueLinks
+func (s *IssueService) GetRemoteLinks(issueID string) ([]RemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links := []RemoteLink{}
+	resp, err := s.client.Do(req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+	return links, resp, nil
+}
+
+// AddRemoteLink adds a remote link to an issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue/{issueIdOrKey}/remotelink-createOrUpdateRemoteIssueLink
+func (s *IssueService) AddRemoteLink(issueID string, link *RemoteLink) (*RemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", issueID)
+	payload := &RemoteLink{
+		GlobalID:     link.GlobalID,
+		Application:  link.Application,
+		Relationship: link.Relationship,
+		Object:       link.Object,
+	}
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(RemoteLink)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// CopyRelationshipsOptions specifies what IssueService.CopyRelationships copies
+type CopyRelationshipsOptions struct {
+	// Links copies the links to other JIRA issues, the clone is linked to the same issues as the source
+	Links bool
+	// RemoteLinks copies the links to objects outside of JIRA
+	RemoteLinks bool
+	// BackLink links the clone to the source, e.g. "EX-2 clones EX-1"
+	BackLink bool
+	// BackLinkType is the name of the link type used for BackLink.
+	// Default: "Cloners", the back link is skipped if the instance doesn't have that link type.
+	BackLinkType string
+}
+
+// CopyRelationshipsResult summarizes what IssueService.CopyRelationships copied
+type CopyRelationshipsResult struct {
+	CloneKey    string
+	Links       int
+	RemoteLinks int
+	BackLink    bool
+}
+
+// CopyRelationships copies the issue links and remote links of the issue sourceKey to the issue cloneKey,
+// like JIRA does when cloning an issue in the UI. Links between source and clone are not copied.
+// It stops at the first failing request, the result tells what has been copied until then.
+func (s *IssueService) CopyRelationships(sourceKey, cloneKey string, options *CopyRelationshipsOptions) (*CopyRelationshipsResult, error) {
+	if options == nil {
+		options = &CopyRelationshipsOptions{}
+	}
+	result := &CopyRelationshipsResult{CloneKey: cloneKey}
+
+	if options.Links {
+		source, _, err := s.Get(sourceKey, &GetQueryOptions{Fields: "issuelinks"})
+		if err != nil {
+			return result, err
+		}
+		var links []*IssueLink
+		if source.Fields != nil {
+			links = source.Fields.IssueLinks
+		}
+		for _, link := range links {
+			copied := &IssueLink{Type: IssueLinkType{Name: link.Type.Name}}
+			switch {
+			case link.OutwardIssue != nil && link.OutwardIssue.Key != cloneKey:
+				copied.InwardIssue = &Issue{Key: cloneKey}
+				copied.OutwardIssue = &Issue{Key: link.OutwardIssue.Key}
+			case link.InwardIssue != nil && link.InwardIssue.Key != cloneKey:
+				copied.InwardIssue = &Issue{Key: link.InwardIssue.Key}
+				copied.OutwardIssue = &Issue{Key: cloneKey}
+			default:
+				continue
+			}
+			if _, err := s.AddLink(copied); err != nil {
+				return result, err
+			}
+			result.Links++
+		}
+	}
+
+	if options.RemoteLinks {
+		links, _, err := s.GetRemoteLinks(sourceKey)
+		if err != nil {
+			return result, err
+		}
+		for i := range links {
+			if _, _, err := s.AddRemoteLink(cloneKey, &links[i]); err != nil {
+				return result, err
+			}
+			result.RemoteLinks++
+		}
+	}
+
+	if options.BackLink {
+		linkType := options.BackLinkType
+		if linkType == "" {
+			types, _, err := s.client.IssueLinkType.GetList()
+			if err != nil {
+				return result, err
+			}
+			for _, t := range types {
+				if t.Name == "Cloners" {
+					linkType = t.Name
+				}
+			}
+		}
+		if linkType != "" {
+			backLink := &IssueLink{
+				Type:         IssueLinkType{Name: linkType},
+				InwardIssue:  &Issue{Key: cloneKey},
+				OutwardIssue: &Issue{Key: sourceKey},
+			}
+			if _, err := s.AddLink(backLink); err != nil {
+				return result, err
+			}
+			result.BackLink = true
+		}
+	}
+
+	return result, nil
+}
+
+// Search will search for tickets according to the jql
+//
+// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
+func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, s.searchURL(jql, options)), nil)
+	if err != nil {
+		return []Issue{}, nil, err
+	}
+
+	v := new(searchResult)
+	resp, err := s.client.Do(req, v)
+	return v.Issues, resp, err
+}
+
+// searchURL builds the URL of a JQL search
+func (s *IssueService) searchURL(jql string, options *SearchOptions) string {
+	var u string
+	if options == nil {
+		u = fmt.Sprintf("rest/api/2/search?jql=%s", url.QueryEscape(jql))
+		if s.client.DefaultPageSize > 0 {
+			u += fmt.Sprintf("&maxResults=%d", s.client.DefaultPageSize)
+		}
+	} else {
+		if len(options.OrderBy) > 0 {
+			jql = orderByJQL(jql, options.OrderBy)
+		}
+		u = fmt.Sprintf("rest/api/2/search?jql=%s&startAt=%d", url.QueryEscape(jql), options.StartAt)
+		if pageSize := s.client.pageSize(options.MaxResults); pageSize > 0 {
+			u += fmt.Sprintf("&maxResults=%d", pageSize)
+		}
+		if options.ValidateQuery != "" {
+			u += fmt.Sprintf("&validateQuery=%s", url.QueryEscape(options.ValidateQuery))
+		}
+		if len(options.Fields) > 0 {
+			u += fmt.Sprintf("&fields=%s", url.QueryEscape(strings.Join(options.Fields, ",")))
+		}
+	}
+	return u
+}
+
+// SearchProject searches for issues like Search and passes the raw JSON of every found issue to project,
+// following the pages of the result starting at options.StartAt until all issues have been processed.
+// The response is decoded as a stream and no Issue is allocated, which keeps the memory usage low and
+// constant for large scans. The price is that project has to decode what it needs itself, e.g. with
+// json.Unmarshal into a small struct. raw is reused for the next issue, copy it to keep it beyond the call.
+// If project returns an error, the search is stopped and the error is returned.
+func (s *IssueService) SearchProject(jql string, options *SearchOptions, project func(raw json.RawMessage) error) (*Response, error) {
+	opts := SearchOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	for {
+		req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, s.searchURL(jql, &opts)), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.client.Do(req, nil)
+		if err != nil {
+			return resp, err
+		}
+
+		n, err := streamSearchResult(resp, project)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		opts.StartAt += n
+		if n == 0 || opts.StartAt >= resp.Total {
+			return resp, nil
+		}
+	}
+}
+
+// streamSearchResult decodes a search result from the body of resp, passing every issue to project.
+// The paging values are stored in resp. It returns the number of issues found.
+func streamSearchResult(resp *Response, project func(raw json.RawMessage) error) (int, error) {
+	decoder := json.NewDecoder(resp.Body)
+	if _, err := decoder.Token(); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	var raw json.RawMessage
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return n, err
+		}
+		switch token {
+		case "issues":
+			if _, err := decoder.Token(); err != nil {
+				return n, err
+			}
+			for decoder.More() {
+				if err := decoder.Decode(&raw); err != nil {
+					return n, err
+				}
+				n++
+				if err := project(raw); err != nil {
+					return n, err
+				}
+			}
+			if _, err := decoder.Token(); err != nil {
+				return n, err
+			}
+		case "startAt":
+			err = decoder.Decode(&resp.StartAt)
+		case "maxResults":
+			err = decoder.Decode(&resp.MaxResults)
+		case "total":
+			err = decoder.Decode(&resp.Total)
+		default:
+			err = decoder.Decode(&raw)
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// SearchInto works like Search, but decodes the found issues into v instead of []Issue.
+// v must be a pointer to a slice of a type JIRA issues can be decoded into,
+// e.g. a struct embedding Issue which adds typed custom fields:
+//
+//	type MyIssue struct {
+//		jira.Issue
+//		Fields struct {
+//			Summary     string  `json:"summary"`
+//			StoryPoints float64 `json:"customfield_10016"`
+//		} `json:"fields"`
+//	}
+//
+//	var issues []MyIssue
+//	resp, err := client.Issue.SearchInto("project = EX", nil, &issues)
+func (s *IssueService) SearchInto(jql string, options *SearchOptions, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, s.searchURL(jql, options)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, &typedSearchResult{Issues: v})
+}
+
+// GetInto works like Get, but decodes the issue into v instead of an Issue.
+// v must be a pointer to a type a JIRA issue can be decoded into, see SearchInto.
+func (s *IssueService) GetInto(issueID string, options *GetQueryOptions, v interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	return s.client.Do(req, v)
+}
+
+// SearchUpdatedSince searches for issues matching jql that were updated at or after since,
+// ordered by their updated time ascending. It is intended for incremental synchronisation.
+//
+// JQL only supports minute precision for date comparisons, so since is truncated to the
+// minute and formatted in its own location. JIRA evaluates the value in the time zone of
+// the authenticated user, so pass since in that zone. Because of the truncation, issues
+// updated within the same minute as since are returned again and should be de-duplicated
+// by the caller.
+//
+// jql must not contain an ORDER BY clause and options.OrderBy is ignored. An empty jql matches all issues.
+//
+// The returned time is the newest updated time seen in the result, to be used as since for
+// the next call, in the location of since. If no issue carries a parsable updated time,
+// since is returned unchanged.
+func (s *IssueService) SearchUpdatedSince(jql string, since time.Time, options *SearchOptions) ([]Issue, time.Time, *Response, error) {
+	clause := fmt.Sprintf("updated >= \"%s\"", since.Format("2006-01-02 15:04"))
+	if strings.TrimSpace(jql) != "" {
+		clause = fmt.Sprintf("(%s) AND %s", jql, clause)
+	}
+	clause += " ORDER BY updated ASC"
+	if options != nil && len(options.OrderBy) > 0 {
+		o := *options
+		o.OrderBy = nil
+		options = &o
+	}
+
+	issues, resp, err := s.Search(clause, options)
+	if err != nil {
+		return issues, since, resp, err
+	}
+
+	cursor := since
+	for _, issue := range issues {
+		if issue.Fields == nil || issue.Fields.Updated == "" {
+			continue
+		}
+		updated, err := time.Parse("2006-01-02T15:04:05.999-0700", issue.Fields.Updated)
+		if err != nil {
+			continue
+		}
+		if updated.After(cursor) {
+			cursor = updated
+		}
+	}
+
+	// JIRA answers in the server's zone, the next query has to be formatted in the one of since
+	return issues, cursor.In(since.Location()), resp, nil
+}
+
+// CountByField counts the issues matching jql grouped by the values of field.
+// JQL has no group by, so one search without results is run per value, DefaultConcurrency at a time.
+// Rate limited searches are retried with an exponential backoff.
+// If no values are given, all values known to JIRA are counted for the fields
+// "status", "priority", "issuetype" and "resolution" (see MetadataService.Bootstrap).
+// The counts are keyed by value, values without issues are included with a count of 0.
+func (s *IssueService) CountByField(jql, field string, values ...string) (map[string]int, error) {
+	if len(values) == 0 {
+		switch field {
+		case "status", "priority", "issuetype", "resolution":
+		default:
+			return nil, fmt.Errorf("Values of field %q can't be determined, pass them explicitly", field)
+		}
+		metadata, err := s.client.Metadata.Bootstrap()
+		if err != nil {
+			return nil, err
+		}
+		switch field {
+		case "status":
+			for _, v := range metadata.Statuses {
+				values = append(values, v.Name)
+			}
+		case "priority":
+			for _, v := range metadata.Priorities {
+				values = append(values, v.Name)
+			}
+		case "issuetype":
+			for _, v := range metadata.IssueTypes {
+				values = append(values, v.Name)
+			}
+		case "resolution":
+			for _, v := range metadata.Resolutions {
+				values = append(values, v.Name)
+			}
+		}
+	}
+
+	counts := make([]int, len(values))
+	errs := make([]error, len(values))
+	runConcurrently(len(values), DefaultConcurrency, func(i int) {
+		clause := fmt.Sprintf("%s = %s", field, quoteJQL(values[i]))
+		if strings.TrimSpace(jql) != "" {
+			clause = fmt.Sprintf("(%s) AND %s", jql, clause)
+		}
+		_, errs[i] = s.client.doWithBackoff(func() (*Response, error) {
+			// Search would replace maxResults=0 by Client.DefaultPageSize
+			u := fmt.Sprintf("rest/api/2/search?jql=%s&maxResults=0", url.QueryEscape(clause))
+			req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, u), nil)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := s.client.Do(req, new(searchResult))
+			if err == nil {
+				counts[i] = resp.Total
+			}
+			return resp, err
+		})
+	})
+
+	result := make(map[string]int, len(values))
+	for i, value := range values {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		result[value] = counts[i]
+	}
+	return result, nil
+}
+
+// ExportCSV searches for all issues matching jql and writes them as CSV to w, similar to the CSV export of JIRA.
+// The first column holds the issue key, followed by one column per entry in fields.
+// fields may contain field IDs (e.g. "summary", "customfield_10016") or field names (e.g. "Story Points"),
+// names are resolved via FieldService.GetList. The header row holds the field names.
+//
+// Values are rendered as strings: date times are formatted as "2006-01-02 15:04",
+// objects are rendered by their value, display name, name or key and arrays are joined by ", ".
+// Results are fetched page by page until all issues have been written.
+func (s *IssueService) ExportCSV(jql string, fields []string, w io.Writer) (*Response, error) {
+	fieldList, resp, err := s.client.Field.GetList()
+	if err != nil {
+		return resp, err
+	}
+
+	ids := make([]string, len(fields))
+	header := make([]string, len(fields)+1)
+	header[0] = "Key"
+	for i, f := range fields {
+		field, ok := findField(fieldList, f)
+		if !ok {
+			return nil, fmt.Errorf("Field %q is unknown to JIRA", f)
+		}
+		ids[i] = field.ID
+		header[i+1] = field.Name
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+
+	options := &SearchOptions{MaxResults: 100, Fields: ids}
+	for {
+		issues, resp, err := s.Search(jql, options)
+		if err != nil {
+			return resp, err
+		}
+
+		for _, issue := range issues {
+			values, err := issueFieldValues(issue.Fields)
+			if err != nil {
+				return resp, err
+			}
+			record := make([]string, len(ids)+1)
+			record[0] = issue.Key
+			for i, id := range ids {
+				record[i+1] = csvValue(values[id])
+			}
+			if err := cw.Write(record); err != nil {
+				return resp, err
+			}
+		}
+
+		options.StartAt += len(issues)
+		if len(issues) == 0 || options.StartAt >= resp.Total {
+			cw.Flush()
+			return resp, cw.Error()
+		}
+	}
+}
+
+// findField looks up a field by its ID or (case insensitive) by its name
+func findField(fields []Field, f string) (Field, bool) {
+	for _, field := range fields {
+		if field.ID == f {
+			return field, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, f) {
+			return field, true
+		}
+	}
+	return Field{}, false
+}
+
+// issueFieldValues returns the fields of an issue as generic map keyed by field ID
+func issueFieldValues(fields *IssueFields) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if fields == nil {
+		return values, nil
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &values)
+	return values, err
+}
+
+// csvValue renders a generic JSON value as a single CSV cell
+func csvValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if t, err := time.Parse("2006-01-02T15:04:05.999-0700", value); err == nil {
+			return t.Format("2006-01-02 15:04")
+		}
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	case []interface{}:
+		parts := make([]string, 0, len(value))
+		for _, item := range value {
+			parts = append(parts, csvValue(item))
+		}
+		return strings.Join(parts, ", ")
+	case map[string]interface{}:
+		for _, key := range []string{"value", "displayName", "name", "key"} {
+			if item, ok := value[key]; ok && item != nil {
+				return csvValue(item)
+			}
+		}
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// GetCustomFields returns a map of customfield_* keys with string values
+func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(map[string]interface{})
+	resp, err := s.client.Do(req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	m := *issue
+	f := m["fields"]
+	cf := make(CustomFields)
+	if f == nil {
+		return cf, resp, nil
+	}
+
+	if rec, ok := f.(map[string]interface{}); ok {
+		for key, val := range rec {
+			if strings.Contains(key, "customfield") {
+				if valMap, ok := val.(map[string]interface{}); ok {
+					if v, ok := valMap["value"]; ok {
+						val = v
+					}
+				}
+				cf[key] = fmt.Sprint(val)
+			}
+		}
+	}
+	return cf, resp, nil
+}
+
+// GetTransitions gets a list of the transitions possible for this issue by the current user,
+// along with fields that are required and their types.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getTransitions
 func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions?expand=transitions.fields", id)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -724,14 +2136,23 @@ func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
 func (s *IssueService) DoTransition(ticketID, transitionID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions", ticketID)
-
 	payload := CreateTransitionPayload{
 		Transition: TransitionPayload{
 			ID: transitionID,
 		},
 	}
-	req, err := s.client.NewRequest("POST", apiEndpoint, payload)
+	return s.DoTransitionWithPayload(ticketID, payload)
+}
+
+// DoTransitionWithPayload performs a transition on an issue using any payload, e.g. a CreateTransitionPayload.
+// Properties of a CreateTransitionPayload are set atomically with the transition,
+// so they are already present when workflow listeners react to the transition.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithPayload(ticketID string, payload interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions", ticketID)
+
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
 	if err != nil {
 		return nil, err
 	}
@@ -744,6 +2165,135 @@ func (s *IssueService) DoTransition(ticketID, transitionID string) (*Response, e
 	return resp, nil
 }
 
+// TransitionWithComment performs a transition on an issue and adds the comment in the same request,
+// so the comment and the status change happen at once. The visibility of the comment is respected.
+// fields are set on the issue as part of the transition and may be nil.
+// If comment is nil, the issue is only transitioned and fields are set.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) TransitionWithComment(issueID, transitionID string, comment *Comment, fields map[string]interface{}) (*Response, error) {
+	payload := CreateTransitionPayload{
+		Transition: TransitionPayload{
+			ID: transitionID,
+		},
+		Fields: fields,
+	}
+	if comment != nil {
+		add := &TransitionComment{Body: comment.Body}
+		if comment.Visibility.Type != "" || comment.Visibility.Value != "" {
+			visibility := comment.Visibility
+			add.Visibility = &visibility
+		}
+		payload.Update = &TransitionUpdate{
+			Comment: []TransitionCommentOperation{{Add: add}},
+		}
+	}
+	return s.DoTransitionWithPayload(issueID, payload)
+}
+
+// RevertLastStatusChange moves the issue back to the status it had before its last status change.
+// The previous status is taken from the changelog of the issue, the issue is then transitioned
+// with an available transition leading to that status.
+// The performed transition is returned. An error is returned if the status of the issue never
+// changed or if no transition back to the previous status is available.
+func (s *IssueService) RevertLastStatusChange(issueID string) (*Transition, *Response, error) {
+	issue, resp, err := s.Get(issueID, &GetQueryOptions{Expand: "changelog"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var last *ChangelogItems
+	var lastCreated time.Time
+	if issue.Changelog != nil {
+		for _, history := range issue.Changelog.Histories {
+			created, _ := time.Parse("2006-01-02T15:04:05.999-0700", history.Created)
+			if last != nil && created.Before(lastCreated) {
+				continue
+			}
+			for i := range history.Items {
+				if history.Items[i].Field == "status" {
+					last = &history.Items[i]
+					lastCreated = created
+				}
+			}
+		}
+	}
+	if last == nil {
+		return nil, resp, fmt.Errorf("The status of issue %s was never changed", issueID)
+	}
+
+	transitions, resp, err := s.GetTransitions(issueID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	from := fmt.Sprintf("%v", last.From)
+	var candidates []Transition
+	for _, transition := range transitions {
+		if transition.To.ID == from {
+			candidates = append(candidates, transition)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, resp, fmt.Errorf("No transition back to status %q is available for issue %s", last.FromString, issueID)
+	}
+	if len(candidates) > 1 {
+		return nil, resp, fmt.Errorf("Transitions %s all lead back to status %q of issue %s, perform one of them by id", describeTransitions(candidates), last.FromString, issueID)
+	}
+
+	resp, err = s.DoTransition(issueID, candidates[0].ID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &candidates[0], resp, nil
+}
+
+// DoTransitionByName performs the transition idOrName on an issue.
+// idOrName is compared with the ids of the available transitions first, then case insensitive with their names.
+// Workflows may have several transitions with the same name (e.g. a transition keeping the issue in its status
+// for side effects), an error listing them is returned in that case instead of performing any of them.
+// The performed transition is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionByName(ticketID, idOrName string) (*Transition, *Response, error) {
+	transitions, resp, err := s.GetTransitions(ticketID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var candidates []Transition
+	for _, transition := range transitions {
+		if transition.ID == idOrName {
+			candidates = []Transition{transition}
+			break
+		}
+		if strings.EqualFold(transition.Name, idOrName) {
+			candidates = append(candidates, transition)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, resp, fmt.Errorf("No transition %q is available for issue %s, available are %s", idOrName, ticketID, describeTransitions(transitions))
+	}
+	if len(candidates) > 1 {
+		return nil, resp, fmt.Errorf("Transition name %q of issue %s is ambiguous, matching %s, perform one of them by id", idOrName, ticketID, describeTransitions(candidates))
+	}
+
+	resp, err = s.DoTransition(ticketID, candidates[0].ID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &candidates[0], resp, nil
+}
+
+// describeTransitions lists transitions for error messages, e.g. `21 "Resolve" (to "Done")`.
+func describeTransitions(transitions []Transition) string {
+	descriptions := make([]string, len(transitions))
+	for i, transition := range transitions {
+		descriptions[i] = fmt.Sprintf("%s %q (to %q)", transition.ID, transition.Name, transition.To.Name)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
 // InitIssueWithMetaAndFields returns Issue with with values from fieldsConfig properly set.
 //  * metaProject should contain metaInformation about the project where the issue should be created.
 //  * metaIssuetype is the MetaInformation about the Issuetype that needs to be created.
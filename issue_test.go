@@ -1,13 +1,17 @@
 package jira
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/trivago/tgo/tcontainer"
 )
@@ -31,374 +35,1571 @@ func TestIssueService_Get_Success(t *testing.T) {
 	}
 }
 
-func TestIssueService_Get_WithQuerySuccess(t *testing.T) {
+func TestIssueService_Get_VersionedRepresentations(t *testing.T) {
 	setup()
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=foo")
-
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=versionedRepresentations")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","versionedRepresentations":{"summary":{"1":"Summary"},"customfield_10100":{"1":"*bold*","2":"<p><b>bold</b></p>"}}}`)
 	})
 
-	opt := &GetQueryOptions{
-		Expand: "foo",
-	}
-	issue, _, err := testClient.Issue.Get("10002", opt)
-	if issue == nil {
-		t.Error("Expected issue. Issue is nil")
-	}
+	issue, _, err := testClient.Issue.Get("10002", &GetQueryOptions{Expand: IssueExpandVersionedRepresentations})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if value, ok := issue.GetVersionedRepresentation("customfield_10100", 1); !ok || value != "*bold*" {
+		t.Errorf("Expected raw value \"*bold*\", got %v", value)
+	}
+	if value, ok := issue.GetVersionedRepresentation("customfield_10100", 2); !ok || value != "<p><b>bold</b></p>" {
+		t.Errorf("Expected rendered value, got %v", value)
+	}
+	if _, ok := issue.GetVersionedRepresentation("summary", 2); ok {
+		t.Error("Expected no second representation of summary")
+	}
 }
 
-func TestIssueService_Create(t *testing.T) {
+func TestIssueService_Get_Schema(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/")
-
-		w.WriteHeader(http.StatusCreated)
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=schema")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"duedate":"2017-08-01","customfield_10016":3},"schema":{"duedate":{"type":"date","system":"duedate"},"labels":{"type":"array","items":"string","system":"labels"},"customfield_10016":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10016}}}`)
 	})
 
-	i := &Issue{
-		Fields: &IssueFields{
-			Description: "example bug report",
-		},
+	issue, _, err := testClient.Issue.Get("10002", &GetQueryOptions{Expand: IssueExpandSchema})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	issue, _, err := testClient.Issue.Create(i)
-	if issue == nil {
-		t.Error("Expected issue. Issue is nil")
+	if issue.Schema["duedate"].Type != "date" {
+		t.Errorf("Expected duedate of type date, got %+v", issue.Schema["duedate"])
 	}
+	if issue.Schema["labels"].Items != "string" {
+		t.Errorf("Expected labels with string items, got %+v", issue.Schema["labels"])
+	}
+	if schema := issue.Schema["customfield_10016"]; schema.CustomID != 10016 || schema.Custom != "com.atlassian.jira.plugin.system.customfieldtypes:float" {
+		t.Errorf("Expected custom field schema, got %+v", schema)
+	}
+}
+
+func TestIssueService_Get_RenderedFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=renderedFields")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"environment":"*Ubuntu* 16.04"},"renderedFields":{"environment":"<p><b>Ubuntu</b> 16.04</p>"}}`)
+	})
+
+	issue, _, err := testClient.Issue.Get("10002", &GetQueryOptions{Expand: IssueExpandRenderedFields})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if issue.Fields.Environment != "*Ubuntu* 16.04" {
+		t.Errorf("Expected environment *Ubuntu* 16.04, got %s", issue.Fields.Environment)
+	}
+	if issue.RenderedFields == nil || issue.RenderedFields.Environment != "<p><b>Ubuntu</b> 16.04</p>" {
+		t.Errorf("Expected rendered environment, got %+v", issue.RenderedFields)
+	}
 }
 
-func TestIssueService_AddComment(t *testing.T) {
+func TestIssueService_Get_RenderedComments(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/comment")
-
-		w.WriteHeader(http.StatusCreated)
-		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}`)
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=renderedFields")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"comment":{"comments":[{"id":"10000","body":"*first*"},{"id":"10001","body":"_second_"}]}},"renderedFields":{"comment":{"comments":[{"id":"10000","body":"<p><b>first</b></p>"},{"id":"10001","body":"<p><em>second</em></p>"}],"maxResults":2,"total":2,"startAt":0}}}`)
 	})
 
-	c := &Comment{
-		Body: "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.",
-		Visibility: CommentVisibility{
-			Type:  "role",
-			Value: "Administrators",
-		},
+	issue, _, err := testClient.Issue.Get("10002", &GetQueryOptions{Expand: IssueExpandRenderedFields})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	comment, _, err := testClient.Issue.AddComment("10000", c)
-	if comment == nil {
-		t.Error("Expected Comment. Comment is nil")
+	expected := map[string]string{
+		"10000": "<p><b>first</b></p>",
+		"10001": "<p><em>second</em></p>",
 	}
+	if bodies := issue.RenderedFields.CommentBodies(); !reflect.DeepEqual(bodies, expected) {
+		t.Errorf("Expected rendered comments %v, got %v", expected, bodies)
+	}
+}
+
+func TestIssueService_Get_Transitions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=transitions")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{},"transitions":[{"id":"2","name":"Close Issue","to":{"id":"6","name":"Closed"}},{"id":"711","name":"QA Review","to":{"id":"10001","name":"In Review"}}]}`)
+	})
+
+	issue, _, err := testClient.Issue.Get("10002", &GetQueryOptions{Expand: IssueExpandTransitions})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if len(issue.Transitions) != 2 {
+		t.Fatalf("Expected 2 transitions, got %d", len(issue.Transitions))
+	}
+	if tr := issue.Transitions[1]; tr.ID != "711" || tr.Name != "QA Review" || tr.To.Name != "In Review" {
+		t.Errorf("Expected transition 711 to In Review, got %+v", tr)
+	}
 }
 
-func TestIssueService_AddLink(t *testing.T) {
+func TestIssueService_Get_ChangelogTruncated(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issueLink")
-
-		w.WriteHeader(http.StatusOK)
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		total := 1
+		if r.URL.Path == "/rest/api/2/issue/EX-1" {
+			total = 150
+		}
+		fmt.Fprintf(w, `{"id":"10002","key":"EX-1","fields":{},"changelog":{"startAt":0,"maxResults":1,"total":%d,"histories":[{"id":"10200","created":"2017-08-01T09:00:00.000+0000","items":[{"field":"status","fromString":"Open","toString":"Closed"}]}]}}`, total)
 	})
 
-	il := &IssueLink{
-		Type: IssueLinkType{
-			Name: "Duplicate",
-		},
-		InwardIssue: &Issue{
-			Key: "HSP-1",
-		},
-		OutwardIssue: &Issue{
-			Key: "MKY-1",
-		},
-		Comment: &Comment{
-			Body: "Linked related issue!",
-			Visibility: CommentVisibility{
-				Type:  "group",
-				Value: "jira-software-users",
-			},
-		},
-	}
-	resp, err := testClient.Issue.AddLink(il)
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
+	issue, _, err := testClient.Issue.Get("EX-1", &GetQueryOptions{Expand: "changelog"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	if !issue.Changelog.Truncated || issue.Changelog.Total != 150 {
+		t.Errorf("Expected a truncated changelog, got %+v", issue.Changelog)
 	}
+
+	issue, _, err = testClient.Issue.Get("EX-2", &GetQueryOptions{Expand: "changelog"})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if issue.Changelog.Truncated {
+		t.Errorf("Expected a complete changelog, got %+v", issue.Changelog)
+	}
 }
 
-func TestIssueService_Get_Fields(t *testing.T) {
+func TestIssueService_Get_WithQuerySuccess(t *testing.T) {
 	setup()
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=foo")
 
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
 	})
 
-	issue, _, err := testClient.Issue.Get("10002", nil)
+	opt := &GetQueryOptions{
+		Expand: "foo",
+	}
+	issue, _, err := testClient.Issue.Get("10002", opt)
 	if issue == nil {
 		t.Error("Expected issue. Issue is nil")
 	}
-	if !reflect.DeepEqual(issue.Fields.Labels, []string{"test"}) {
-		t.Error("Expected labels for the returned issue")
-	}
-
-	if len(issue.Fields.Comments.Comments) != 1 {
-		t.Errorf("Expected one comment, %v found", len(issue.Fields.Comments.Comments))
-	}
-	if issue.Fields.Epic == nil {
-		t.Error("Epic expected but not found")
-	}
-
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_DownloadAttachment(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_Get_WithFieldSelectors(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/secure/attachment/10000/")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
 
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(testAttachment))
+		if fields := r.URL.Query().Get("fields"); fields != "*all,-comment" {
+			t.Errorf("Expected fields selector %q, got %q", "*all,-comment", fields)
+		}
+		fmt.Fprint(w, `{"id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"summary":"example bug report"}}`)
 	})
 
-	resp, err := testClient.Issue.DownloadAttachment("10000")
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
+	opt := &GetQueryOptions{
+		Fields: "*all,-comment",
 	}
-	defer resp.Body.Close()
-
-	attachment, err := ioutil.ReadAll(resp.Body)
+	issue, _, err := testClient.Issue.Get("10002", opt)
 	if err != nil {
-		t.Error("Expected attachment text", err)
+		t.Errorf("Error given: %s", err)
 	}
-	if string(attachment) != testAttachment {
-		t.Errorf("Expecting an attachment: %s", string(attachment))
+	if issue.Fields.Comments != nil {
+		t.Error("Expected no comments")
 	}
+}
 
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+func TestIssueService_Get_WithEditMeta(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?expand=editmeta")
+
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"summary":"example bug report"},"editmeta":{"fields":{"summary":{"required":true,"schema":{"type":"string","system":"summary"},"name":"Summary","hasDefaultValue":false,"operations":["set"]}}}}`)
+	})
+
+	opt := &GetQueryOptions{
+		Expand: "editmeta",
 	}
+	issue, _, err := testClient.Issue.Get("10002", opt)
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if issue.EditMeta == nil {
+		t.Fatal("Expected edit meta. Edit meta is nil")
+	}
+	if required, _ := issue.EditMeta.Fields.Bool("summary/required"); !required {
+		t.Error("Expected summary to be a required field")
+	}
 }
 
-func TestIssueService_DownloadAttachment_BadStatus(t *testing.T) {
-
+func TestIssueService_Create(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/secure/attachment/10000/")
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/")
 
-		w.WriteHeader(http.StatusForbidden)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
 	})
 
-	resp, err := testClient.Issue.DownloadAttachment("10000")
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
+	i := &Issue{
+		Fields: &IssueFields{
+			Description: "example bug report",
+		},
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusForbidden {
-		t.Errorf("Expected Status code %d. Given %d", http.StatusForbidden, resp.StatusCode)
+	issue, _, err := testClient.Issue.Create(i)
+	if issue == nil {
+		t.Error("Expected issue. Issue is nil")
 	}
-	if err == nil {
-		t.Errorf("Error expected")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_PostAttachment(t *testing.T) {
-	var testAttachment = "Here is an attachment"
+// testCreateMeta registers a createmeta handler for the project EX with the issue type Bug
+func testCreateMeta(t *testing.T) {
+	testServerInfo(t, DeploymentTypeServer)
+	testMux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"projects":[{"id":"10000","key":"EX","name":"Example","issuetypes":[{"id":"1","name":"Bug","fields":{
+			"summary":{"required":true,"name":"Summary","hasDefaultValue":false},
+			"priority":{"required":true,"name":"Priority","hasDefaultValue":true},
+			"customfield_10000":{"required":true,"name":"Affected Team","hasDefaultValue":false},
+			"description":{"required":false,"name":"Description","hasDefaultValue":false}
+		}}]}]}`)
+	})
+}
 
+func TestIssueService_CreateFromTemplate(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+	testCreateMeta(t)
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		status := http.StatusOK
-
-		file, _, err := r.FormFile("file")
-		if err != nil {
-			status = http.StatusNotAcceptable
+		payload := map[string]map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := map[string]interface{}{
+			"project":           map[string]interface{}{"key": "EX"},
+			"issuetype":         map[string]interface{}{"name": "Bug"},
+			"summary":           "Crash in checkout",
+			"description":       "Found in checkout by {{reporter}}",
+			"customfield_10000": map[string]interface{}{"value": "Payments"},
 		}
-		if file == nil {
-			status = http.StatusNoContent
-		} else {
-
-			// Read the file into memory
-			data, err := ioutil.ReadAll(file)
-			if err != nil {
-				status = http.StatusInternalServerError
-			}
-			if string(data) != testAttachment {
-				status = http.StatusNotAcceptable
-			}
-
-			w.WriteHeader(status)
-			fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
-			file.Close()
+		if !reflect.DeepEqual(payload["fields"], expected) {
+			t.Errorf("Expected fields %v, got %v", expected, payload["fields"])
 		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","self":"http://www.example.com/jira/rest/api/2/issue/10002"}`)
 	})
 
-	reader := strings.NewReader(testAttachment)
-
-	issue, resp, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
-
-	if issue == nil {
-		t.Error("Expected response. Response is nil")
-	}
-
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	template := &IssueTemplate{
+		ProjectKey: "EX",
+		IssueType:  "Bug",
+		Fields: map[string]interface{}{
+			"summary":           "Crash in {{component}}",
+			"description":       "Found in {{component}} by {{reporter}}",
+			"customfield_10000": map[string]string{"value": "Unassigned"},
+		},
 	}
-
+	issue, _, err := testClient.Issue.CreateFromTemplate(template, map[string]interface{}{
+		"component":         "checkout",
+		"customfield_10000": map[string]string{"value": "Payments"},
+	})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, got %+v", issue)
+	}
 }
 
-func TestIssueService_PostAttachment_NoResponse(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_CreateFromTemplate_MissingRequired(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
+	testCreateMeta(t)
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no issue to be created")
 	})
-	reader := strings.NewReader(testAttachment)
 
-	_, _, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
+	template := &IssueTemplate{ProjectKey: "EX", IssueType: "Bug", Fields: map[string]interface{}{"description": "no summary"}}
+	_, _, err := testClient.Issue.CreateFromTemplate(template, nil)
+	if err == nil || err.Error() != "Required fields are missing: Affected Team, Summary" {
+		t.Errorf("Expected an error about the missing fields, got %v", err)
+	}
+}
 
-	if err == nil {
+func TestIssueService_UpdateIssue(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	data := map[string]interface{}{
+		"fields": map[string]interface{}{"summary": "new summary"},
+	}
+	if _, err := testClient.Issue.UpdateIssue("EX-1", data); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+// testMetadata registers the handlers required by MetadataService.Bootstrap with the given fields
+func testMetadata(fields string) {
+	testMux.HandleFunc("/rest/api/2/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issueLinkTypes":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fields)
+	})
+}
+
+func TestIssueService_Flag(t *testing.T) {
+	setup()
+	defer teardown()
+	testMetadata(`[{"id":"customfield_10000","name":"Flagged","custom":true,"schema":{"type":"array","items":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes","customId":10000}}]`)
+
+	var payloads []string
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		payloads = append(payloads, strings.TrimSpace(string(body)))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.Flag("EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.Unflag("EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	expected := []string{
+		`{"fields":{"customfield_10000":[{"value":"Impediment"}]}}`,
+		`{"fields":{"customfield_10000":[]}}`,
+	}
+	if !reflect.DeepEqual(payloads, expected) {
+		t.Errorf("Expected payloads %v, got %v", expected, payloads)
+	}
+}
+
+func TestIssueService_Flag_NotConfigured(t *testing.T) {
+	setup()
+	defer teardown()
+	testMetadata(`[{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}}]`)
+
+	if _, err := testClient.Issue.Flag("EX-1"); err == nil {
+		t.Error("Expected an error if the Flagged field is not configured")
+	}
+}
+
+func TestIssueService_DeletePropertyBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/properties/automation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/properties/automation")
+
+		var filter BulkPropertyDeleteFilter
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(filter.EntityIds) != 2 {
+			t.Errorf("Expected 2 entity ids, got %d", len(filter.EntityIds))
+		}
+
+		w.Header().Set("Location", "/rest/api/2/task/10641")
+		w.WriteHeader(http.StatusSeeOther)
+	})
+	testMux.HandleFunc("/rest/api/2/task/10641", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/10641","id":"10641","status":"ENQUEUED","progress":0}`)
+	})
+
+	location, _, err := testClient.Issue.DeletePropertyBulk("automation", &BulkPropertyDeleteFilter{EntityIds: []int64{10100, 100010}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !strings.HasSuffix(location, "/rest/api/2/task/10641") {
+		t.Errorf("Expected task location, got %s", location)
+	}
+}
+
+func TestIssueService_DeletePropertyBulk_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/properties/automation", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request in a dry run")
+	})
+
+	testClient.DryRun = true
+	testClient.Logger = log.New(ioutil.Discard, "", 0)
+	location, resp, err := testClient.Issue.DeletePropertyBulk("automation", &BulkPropertyDeleteFilter{EntityIds: []int64{10100}})
+	if err != nil || location != "" || !resp.DryRun {
+		t.Errorf("Expected an empty location in a dry run, got %q, %v", location, err)
+	}
+}
+
+func TestIssueService_ArchiveByJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
+	testMux.HandleFunc("/rest/api/2/issue/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/archive")
+
+		var jql string
+		if err := json.NewDecoder(r.Body).Decode(&jql); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if jql != "project = EX AND resolved < -365d" {
+			t.Errorf("Unexpected JQL %q", jql)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `"http://www.example.com/jira/rest/api/2/task/10300"`)
+	})
+
+	taskID, _, err := testClient.Issue.ArchiveByJQL("project = EX AND resolved < -365d")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if taskID != "10300" {
+		t.Errorf("Expected task id 10300, got %s", taskID)
+	}
+}
+
+func TestIssueService_ArchiveByJQL_NoTask(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
+	testMux.HandleFunc("/rest/api/2/issue/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `""`)
+	})
+
+	taskID, _, err := testClient.Issue.ArchiveByJQL("project = EX")
+	if err == nil || taskID != "" {
+		t.Errorf("Expected an error without a task, got %q, %v", taskID, err)
+	}
+
+	testClient.DryRun = true
+	testClient.Logger = log.New(ioutil.Discard, "", 0)
+	taskID, resp, err := testClient.Issue.ArchiveByJQL("project = EX")
+	if err != nil || taskID != "" || !resp.DryRun {
+		t.Errorf("Expected an empty task id in a dry run, got %q, %v", taskID, err)
+	}
+}
+
+func TestIssueService_ArchiveByJQL_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeCloud)
+	testMux.HandleFunc("/rest/api/2/issue/archive", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no archive request on JIRA Cloud")
+	})
+
+	if _, _, err := testClient.Issue.ArchiveByJQL("project = EX"); err == nil {
+		t.Error("Expected an error on JIRA Cloud")
+	}
+}
+
+func TestIssueService_DeleteWithOptions_Snapshot(t *testing.T) {
+	setup()
+	defer teardown()
+	deleted := false
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testRequestURL(t, r, "/rest/api/2/issue/10002?fields=summary%2Cstatus")
+			fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"summary":"Obsolete","status":{"name":"Open"}}}`)
+		case "DELETE":
+			testRequestURL(t, r, "/rest/api/2/issue/10002?deleteSubtasks=true")
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	snapshot, _, err := testClient.Issue.DeleteWithOptions("10002", &DeleteOptions{DeleteSubtasks: true, Snapshot: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !deleted {
+		t.Error("Expected the issue to be deleted")
+	}
+	if snapshot == nil || snapshot.Key != "EX-1" || snapshot.Fields.Summary != "Obsolete" || snapshot.Fields.Status.Name != "Open" {
+		t.Errorf("Unexpected snapshot %+v", snapshot)
+	}
+}
+
+func TestIssueService_DeleteWithOptions_WithoutSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	snapshot, _, err := testClient.Issue.DeleteWithOptions("10002", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if snapshot != nil {
+		t.Errorf("Expected no snapshot, got %+v", snapshot)
+	}
+}
+
+func TestIssueService_AddComment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}`)
+	})
+
+	c := &Comment{
+		Body: "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.",
+		Visibility: CommentVisibility{
+			Type:  "role",
+			Value: "Administrators",
+		},
+	}
+	comment, _, err := testClient.Issue.AddComment("10000", c)
+	if comment == nil {
+		t.Error("Expected Comment. Comment is nil")
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddComments(t *testing.T) {
+	setup()
+	defer teardown()
+	var bodies []string
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		comment := new(Comment)
+		json.NewDecoder(r.Body).Decode(comment)
+		bodies = append(bodies, comment.Body)
+		if comment.Body == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"%d","body":%q,"created":%q}`, 10000+len(bodies), comment.Body, comment.Created)
+	})
+
+	results := testClient.Issue.AddComments("10000", []*Comment{
+		{Body: "first", Created: "2016-03-16T04:22:37.356+0000"},
+		{Body: "fail"},
+		{Body: "third"},
+	})
+	if !reflect.DeepEqual(bodies, []string{"first", "fail", "third"}) {
+		t.Errorf("Expected comments to be added in order, got %v", bodies)
+	}
+	if results[0].Error != nil || results[0].Comment.ID != "10001" || results[0].Comment.Created != "2016-03-16T04:22:37.356+0000" {
+		t.Errorf("Unexpected result %+v", results[0])
+	}
+	if results[1].Error == nil || results[1].Comment != nil {
+		t.Errorf("Expected an error for the second comment, got %+v", results[1])
+	}
+	if results[2].Error != nil || results[2].Comment.ID != "10003" {
+		t.Errorf("Unexpected result %+v", results[2])
+	}
+}
+
+func TestIssueService_AddCommentBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		comment := new(Comment)
+		json.NewDecoder(r.Body).Decode(comment)
+		if comment.Visibility.Type != "role" || comment.Visibility.Value != "Developers" {
+			t.Errorf("Expected the visibility to be sent, got %+v", comment.Visibility)
+		}
+		if r.URL.Path == "/rest/api/2/issue/EX-2/comment" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"10000","body":%q}`, comment.Body)
+	})
+
+	comment := &Comment{Body: "Rescheduled to the next release", Visibility: CommentVisibility{Type: "role", Value: "Developers"}}
+	results := testClient.Issue.AddCommentBulk([]string{"EX-1", "EX-2", "EX-3"}, comment, 2)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	for i, key := range []string{"EX-1", "EX-2", "EX-3"} {
+		if results[i].IssueKey != key {
+			t.Errorf("Expected result %d for %s, got %s", i, key, results[i].IssueKey)
+		}
+		if failed := results[i].Error != nil; failed != (key == "EX-2") {
+			t.Errorf("Unexpected result for %s: %+v", key, results[i])
+		}
+	}
+}
+
+func TestIssueService_AddLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issueLink")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	il := &IssueLink{
+		Type: IssueLinkType{
+			Name: "Duplicate",
+		},
+		InwardIssue: &Issue{
+			Key: "HSP-1",
+		},
+		OutwardIssue: &Issue{
+			Key: "MKY-1",
+		},
+		Comment: &Comment{
+			Body: "Linked related issue!",
+			Visibility: CommentVisibility{
+				Type:  "group",
+				Value: "jira-software-users",
+			},
+		},
+	}
+	resp, err := testClient.Issue.AddLink(il)
+	if resp == nil {
+		t.Error("Expected response. Response is nil")
+	}
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_GetRemoteLinks(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/remotelink")
+		fmt.Fprint(w, `[{"id":10000,"self":"http://www.example.com/jira/rest/api/issue/EX-1/remotelink/10000","globalId":"system=http://www.mycompany.com/support&id=1","application":{"type":"com.acme.tracker","name":"My Acme Tracker"},"relationship":"causes","object":{"url":"http://www.mycompany.com/support?id=1","title":"TSTSUP-111","summary":"Crazy customer support issue","icon":{"url16x16":"http://www.mycompany.com/support/ticket.png","title":"Support Ticket"}}}]`)
+	})
+
+	links, _, err := testClient.Issue.GetRemoteLinks("EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(links) != 1 || links[0].Object.Title != "TSTSUP-111" || links[0].Application.Name != "My Acme Tracker" {
+		t.Errorf("Unexpected remote links %+v", links)
+	}
+}
+
+func TestIssueService_CopyRelationships(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=issuelinks")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"issuelinks":[
+			{"id":"1","type":{"name":"Blocks"},"outwardIssue":{"key":"EX-3"}},
+			{"id":"2","type":{"name":"Relates"},"inwardIssue":{"key":"EX-4"}},
+			{"id":"3","type":{"name":"Cloners"},"inwardIssue":{"key":"EX-2"}}
+		]}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":10000,"globalId":"docs-1","relationship":"mentioned in","object":{"url":"http://www.example.com/docs","title":"Docs"}}]`)
+	})
+	var remoteLinks []RemoteLink
+	testMux.HandleFunc("/rest/api/2/issue/EX-2/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		link := RemoteLink{}
+		json.NewDecoder(r.Body).Decode(&link)
+		remoteLinks = append(remoteLinks, link)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10001,"self":"http://www.example.com/jira/rest/api/issue/EX-2/remotelink/10001"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"1000","name":"Blocks"},{"id":"1010","name":"Cloners","inward":"is cloned by","outward":"clones"}]}`)
+	})
+	var links []string
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		link := new(IssueLink)
+		json.NewDecoder(r.Body).Decode(link)
+		links = append(links, fmt.Sprintf("%s %s %s", link.InwardIssue.Key, link.Type.Name, link.OutwardIssue.Key))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	result, err := testClient.Issue.CopyRelationships("EX-1", "EX-2", &CopyRelationshipsOptions{Links: true, RemoteLinks: true, BackLink: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	expectedLinks := []string{"EX-2 Blocks EX-3", "EX-4 Relates EX-2", "EX-2 Cloners EX-1"}
+	if !reflect.DeepEqual(links, expectedLinks) {
+		t.Errorf("Expected links %v, got %v", expectedLinks, links)
+	}
+	if len(remoteLinks) != 1 || remoteLinks[0].GlobalID != "docs-1" || remoteLinks[0].ID != 0 || remoteLinks[0].Object.URL != "http://www.example.com/docs" {
+		t.Errorf("Unexpected remote links %+v", remoteLinks)
+	}
+	expected := &CopyRelationshipsResult{CloneKey: "EX-2", Links: 2, RemoteLinks: 1, BackLink: true}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestIssueService_Get_Fields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
+
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+	})
+
+	issue, _, err := testClient.Issue.Get("10002", nil)
+	if issue == nil {
+		t.Error("Expected issue. Issue is nil")
+	}
+	if !reflect.DeepEqual(issue.Fields.Labels, []string{"test"}) {
+		t.Error("Expected labels for the returned issue")
+	}
+
+	if len(issue.Fields.Comments.Comments) != 1 {
+		t.Errorf("Expected one comment, %v found", len(issue.Fields.Comments.Comments))
+	}
+	if issue.Fields.Epic == nil {
+		t.Error("Epic expected but not found")
+	}
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DownloadAttachment(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/secure/attachment/10000/")
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testAttachment))
+	})
+
+	resp, err := testClient.Issue.DownloadAttachment("10000")
+	if resp == nil {
+		t.Error("Expected response. Response is nil")
+	}
+	defer resp.Body.Close()
+
+	attachment, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Error("Expected attachment text", err)
+	}
+	if string(attachment) != testAttachment {
+		t.Errorf("Expecting an attachment: %s", string(attachment))
+	}
+
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DownloadAttachment_BadStatus(t *testing.T) {
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/secure/attachment/10000/")
+
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	resp, err := testClient.Issue.DownloadAttachment("10000")
+	if resp == nil {
+		t.Error("Expected response. Response is nil")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected Status code %d. Given %d", http.StatusForbidden, resp.StatusCode)
+	}
+	if err == nil {
+		t.Errorf("Error expected")
+	}
+}
+
+func TestIssueService_PostAttachment(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		status := http.StatusOK
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			status = http.StatusNotAcceptable
+		}
+		if file == nil {
+			status = http.StatusNoContent
+		} else {
+
+			// Read the file into memory
+			data, err := ioutil.ReadAll(file)
+			if err != nil {
+				status = http.StatusInternalServerError
+			}
+			if string(data) != testAttachment {
+				status = http.StatusNotAcceptable
+			}
+
+			w.WriteHeader(status)
+			fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+			file.Close()
+		}
+	})
+
+	reader := strings.NewReader(testAttachment)
+
+	issue, resp, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
+
+	if issue == nil {
+		t.Error("Expected response. Response is nil")
+	}
+
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	}
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_PostAttachment_NoResponse(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+	})
+	reader := strings.NewReader(testAttachment)
+
+	_, _, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
+
+	if err == nil {
+		t.Errorf("Error expected: %s", err)
+	}
+}
+
+func TestIssueService_PostAttachment_NoFilename(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	})
+	reader := strings.NewReader(testAttachment)
+
+	_, _, err := testClient.Issue.PostAttachment("10000", reader, "")
+
+	if err != nil {
 		t.Errorf("Error expected: %s", err)
 	}
 }
 
-func TestIssueService_PostAttachment_NoFilename(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_PostAttachment_NoAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	})
+
+	_, _, err := testClient.Issue.PostAttachment("10000", nil, "attachment")
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Exists(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("fields") != "key" {
+			t.Errorf("Expected fields=key, got %s", r.URL.RawQuery)
+		}
+		switch r.URL.Path {
+		case "/rest/api/2/issue/EX-1":
+			fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+		case "/rest/api/2/issue/EX-2":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		issueID    string
+		exists     bool
+		statusCode int
+	}{
+		{"EX-1", true, http.StatusOK},
+		{"EX-2", false, http.StatusForbidden},
+		{"EX-3", false, http.StatusNotFound},
+	}
+	for _, test := range tests {
+		exists, resp, err := testClient.Issue.Exists(test.issueID)
+		if err != nil {
+			t.Errorf("Error given for %s: %s", test.issueID, err)
+		}
+		if exists != test.exists || resp.StatusCode != test.statusCode {
+			t.Errorf("Expected %s to exist %v with status %d, got %v with status %d", test.issueID, test.exists, test.statusCode, exists, resp.StatusCode)
+		}
+	}
+}
+
+func TestIssueService_GetDetails(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=summary")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"summary":"example"}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":1,"comments":[{"id":"10000","body":"Lorem ipsum"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":1,"worklogs":[{"id":"100028","timeSpentSeconds":12000,"started":"2016-03-16T04:22:37.471+0000","created":"2016-03-16T04:22:37.471+0000","updated":"2016-03-16T04:22:37.471+0000"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"watchCount":1,"watchers":[{"name":"fred"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/mypermissions", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/rest/api/2/mypermissions?issueKey=EX-1")
+		fmt.Fprint(w, `{"permissions":{"EDIT_ISSUES":{"id":"12","key":"EDIT_ISSUES","name":"Edit Issues","type":"PROJECT","havePermission":true}}}`)
+	})
+
+	details, err := testClient.Issue.GetDetails(context.Background(), "EX-1", &GetQueryOptions{Fields: "summary"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if details.Issue.Fields.Summary != "example" {
+		t.Errorf("Expected summary example, got %s", details.Issue.Fields.Summary)
+	}
+	if len(details.Comments) != 1 || len(details.Worklogs) != 1 || len(details.Watchers) != 1 {
+		t.Errorf("Expected one comment, worklog and watcher, got %+v", details)
+	}
+	if !details.Permissions["EDIT_ISSUES"].HavePermission {
+		t.Error("Expected EDIT_ISSUES permission")
+	}
+}
+
+func TestIssueService_GetDetails_Unauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := testClient.Issue.GetDetails(context.Background(), "EX-1", nil)
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("Expected an authentication error, got %v", err)
+	}
+}
+
+func TestIssueService_GetCommentCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/comment?maxResults=0")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":0,"total":5,"comments":[]}`)
+	})
+
+	count, _, err := testClient.Issue.GetCommentCount("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if count != 5 {
+		t.Errorf("Expected 5 comments, got %d", count)
+	}
+}
+
+func TestIssueService_GetSubtaskProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=subtasks")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"subtasks":[
+			{"id":"10003","key":"EX-2","fields":{"status":{"name":"Closed","statusCategory":{"key":"done"}}}},
+			{"id":"10004","key":"EX-3","fields":{"status":{"name":"Erledigt","statusCategory":{"key":"done"}}}},
+			{"id":"10005","key":"EX-4","fields":{"status":{"name":"Done?","statusCategory":{"key":"indeterminate"}}}}
+		]}}`)
+	})
+
+	progress, _, err := testClient.Issue.GetSubtaskProgress("EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	expected := &SubtaskProgress{Done: 2, Total: 3, Incomplete: []string{"EX-4"}}
+	if !reflect.DeepEqual(progress, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, progress)
+	}
+}
+
+func TestIssueService_GetWorklog(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/worklog/100028?expand=renderedBody")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","created":"2016-03-16T04:22:37.471+0000","updated":"2016-03-16T04:22:37.471+0000","started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}`)
+	})
+
+	worklog, _, err := testClient.Issue.GetWorklog("10002", "100028", &GetWorklogOptions{Expand: "renderedBody"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if worklog == nil || worklog.TimeSpentSeconds != 12000 {
+		t.Errorf("Expected worklog with 12000 seconds spent, got %+v", worklog)
+	}
+}
+
+func TestIssueService_GetWorklog_Deleted(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := testClient.Issue.GetWorklog("10002", "100028", nil)
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Expected a descriptive error, got %v", err)
+	}
+}
+
+func TestIssueService_GetWatchers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":2,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},{"name":"charlie"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user?username=charlie")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/user?username=charlie","name":"charlie","displayName":"Charlie of Atlassian","active":true}`)
+	})
+
+	watchers, _, err := testClient.Issue.GetWatchers("10002", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(watchers) != 2 || watchers[1].DisplayName != "" {
+		t.Errorf("Expected 2 watchers without hydration, got %+v", watchers)
+	}
+
+	watchers, _, err = testClient.Issue.GetWatchers("10002", &GetWatchersOptions{HydrateUsers: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(watchers) != 2 || watchers[0].DisplayName != "Fred F. User" || watchers[1].DisplayName != "Charlie of Atlassian" {
+		t.Errorf("Expected 2 hydrated watchers, got %+v", watchers)
+	}
+}
+
+func TestIssueService_IsWatching(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":true,"watchCount":1,"watchers":[{"name":"fred"}]}`)
+	})
+
+	watching, _, err := testClient.Issue.IsWatching("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !watching {
+		t.Error("Expected the current user to watch the issue")
+	}
+}
+
+func TestIssueService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=something&startAt=1&maxResults=40")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 40,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+	})
+
+	opt := &SearchOptions{StartAt: 1, MaxResults: 40}
+	_, resp, err := testClient.Issue.Search("something", opt)
+
+	if resp == nil {
+		t.Errorf("Response given: %+v", resp)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if resp.StartAt != 1 {
+		t.Errorf("StartAt should populate with 1, %v given", resp.StartAt)
+	}
+	if resp.MaxResults != 40 {
+		t.Errorf("StartAt should populate with 40, %v given", resp.MaxResults)
+	}
+	if resp.Total != 6 {
+		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+	}
+}
+
+func TestIssueService_Search_VotesAndWatches(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&startAt=0&fields=summary%2Cvotes%2Cwatches")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[
+			{"key":"EX-1","fields":{"summary":"First","votes":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/votes","votes":3,"hasVoted":true},"watches":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","watchCount":5,"isWatching":false}}},
+			{"key":"EX-2","fields":{"summary":"Second","votes":{"votes":0,"hasVoted":false},"watches":{"watchCount":1,"isWatching":true}}}
+		]}`)
+	})
+
+	issues, _, err := testClient.Issue.Search("project = EX", &SearchOptions{Fields: []string{"summary", "votes", "watches"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(issues))
+	}
+	if votes := issues[0].Fields.Votes; votes == nil || votes.Votes != 3 || !votes.HasVoted {
+		t.Errorf("Unexpected votes %+v", votes)
+	}
+	if watches := issues[0].Fields.Watches; watches == nil || watches.WatchCount != 5 {
+		t.Errorf("Unexpected watches %+v", watches)
+	}
+	if watches := issues[1].Fields.Watches; watches == nil || watches.WatchCount != 1 || !watches.IsWatching {
+		t.Errorf("Unexpected watches %+v", watches)
+	}
+}
+
+func TestIssueService_Search_WithoutPaging(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=something")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"expand": "schema,names","startAt": 0,"maxResults": 50,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+	})
+
+	_, resp, err := testClient.Issue.Search("something", nil)
+
+	if resp == nil {
+		t.Errorf("Response given: %+v", resp)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if resp.StartAt != 0 {
+		t.Errorf("StartAt should populate with 0, %v given", resp.StartAt)
+	}
+	if resp.MaxResults != 50 {
+		t.Errorf("StartAt should populate with 50, %v given", resp.MaxResults)
+	}
+	if resp.Total != 6 {
+		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+	}
+}
+
+func TestIssueService_Search_DefaultPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.DefaultPageSize = 25
+
+	expected := []string{
+		"/rest/api/2/search?jql=something&maxResults=25",
+		"/rest/api/2/search?jql=something&startAt=50&maxResults=25",
+		"/rest/api/2/search?jql=something&startAt=0&maxResults=10",
+	}
+	var urls []string
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		urls = append(urls, r.URL.String())
+		fmt.Fprint(w, `{"startAt":0,"maxResults":25,"total":0,"issues":[]}`)
+	})
+
+	testClient.Issue.Search("something", nil)
+	testClient.Issue.Search("something", &SearchOptions{StartAt: 50})
+	testClient.Issue.Search("something", &SearchOptions{MaxResults: 10})
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, urls)
+	}
+}
+
+func TestIssueService_Search_NoPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=something&startAt=50")
+		fmt.Fprint(w, `{"startAt":50,"maxResults":50,"total":51,"issues":[{"key":"EX-51"}]}`)
+	})
+
+	issues, _, err := testClient.Issue.Search("something", &SearchOptions{StartAt: 50})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues))
+	}
+}
+
+func TestIssueService_Search_OrderBy(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		expected := `project = EX ORDER BY cf[10016] DESC, "Due Date" ASC, priority DESC`
+		if jql := r.URL.Query().Get("jql"); jql != expected {
+			t.Errorf("Expected jql %s, got %s", expected, jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":0,"issues":[]}`)
+	})
+
+	_, _, err := testClient.Issue.Search("project = EX order by created", &SearchOptions{OrderBy: []OrderClause{
+		{Field: "customfield_10016"},
+		{Field: "Due Date", Asc: true},
+		{Field: "priority"},
+	}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+type testTypedIssue struct {
+	Issue
+	Fields struct {
+		Summary     string  `json:"summary"`
+		StoryPoints float64 `json:"customfield_10016"`
+	} `json:"fields"`
+}
+
+func TestIssueService_SearchInto(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&startAt=0&maxResults=10")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":10,"total":11,"issues":[{"id":"10002","key":"EX-1","fields":{"summary":"Typed","customfield_10016":5}}]}`)
+	})
+
+	var issues []testTypedIssue
+	resp, err := testClient.Issue.SearchInto("project = EX", &SearchOptions{MaxResults: 10}, &issues)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-1" || issues[0].Fields.StoryPoints != 5 {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.Total != 11 {
+		t.Errorf("Expected total 11, got %d", resp.Total)
+	}
+}
+
+func TestIssueService_SearchProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"expand":"schema,names","startAt":0,"maxResults":2,"total":3,"issues":[{"id":"1","key":"EX-1","fields":{"summary":"a"}},{"id":"2","key":"EX-2","fields":{"summary":"b"}}]}`)
+		case "2":
+			fmt.Fprint(w, `{"expand":"schema,names","startAt":2,"maxResults":2,"total":3,"issues":[{"id":"3","key":"EX-3","fields":{"summary":"c"}}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	var keys []string
+	resp, err := testClient.Issue.SearchProject("project = EX", &SearchOptions{MaxResults: 2}, func(raw json.RawMessage) error {
+		issue := struct {
+			Key string `json:"key"`
+		}{}
+		if err := json.Unmarshal(raw, &issue); err != nil {
+			return err
+		}
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(keys, []string{"EX-1", "EX-2", "EX-3"}) {
+		t.Errorf("Expected all issues to be projected, got %v", keys)
+	}
+	if resp.StartAt != 2 || resp.Total != 3 {
+		t.Errorf("Expected the paging values of the last page, got %d and %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestIssueService_SearchProject_CallbackError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=&startAt=0")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[{"id":"1"},{"id":"2"}]}`)
+	})
+
+	calls := 0
+	_, err := testClient.Issue.SearchProject("", nil, func(raw json.RawMessage) error {
+		calls++
+		return fmt.Errorf("stop")
+	})
+	if err == nil || err.Error() != "stop" || calls != 1 {
+		t.Errorf("Expected the search to stop after the first issue, got %v after %d calls", err, calls)
+	}
+}
+
+func TestIssueService_GetInto(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=summary%2Ccustomfield_10016")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"summary":"Typed","customfield_10016":3.5}}`)
+	})
+
+	issue := new(testTypedIssue)
+	if _, err := testClient.Issue.GetInto("EX-1", &GetQueryOptions{Fields: "summary,customfield_10016"}, issue); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue.ID != "10002" || issue.Fields.Summary != "Typed" || issue.Fields.StoryPoints != 3.5 {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
+
+func TestOrderByJQL(t *testing.T) {
+	tests := []struct {
+		jql      string
+		expected string
+	}{
+		{"", "ORDER BY cf[10000] ASC"},
+		{"ORDER BY rank", "ORDER BY cf[10000] ASC"},
+		{"summary ~ \"sorting\" ORDER BY\nrank ASC", "summary ~ \"sorting\" ORDER BY cf[10000] ASC"},
+		{`summary ~ "fix order by clause" AND project = EX order by created DESC`, `summary ~ "fix order by clause" AND project = EX ORDER BY cf[10000] ASC`},
+		{`summary ~ 'it\'s order by' AND project = EX`, `summary ~ 'it\'s order by' AND project = EX ORDER BY cf[10000] ASC`},
+	}
+	for _, test := range tests {
+		if jql := orderByJQL(test.jql, []OrderClause{{Field: "cf[10000]", Asc: true}}); jql != test.expected {
+			t.Errorf("Expected %q for %q, got %q", test.expected, test.jql, jql)
+		}
+	}
+}
+
+func TestIssueService_SearchUpdatedSince(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		expected := "(project = EX) AND updated >= \"2016-04-06 02:30\" ORDER BY updated ASC"
+		if jql := r.URL.Query().Get("jql"); jql != expected {
+			t.Errorf("Expected jql %q, got %q", expected, jql)
+		}
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 2,"issues": [{"id": "10230","key": "EX-1","fields": {"summary": "first","updated": "2016-04-06T02:36:53.594-0700"}},{"id": "10231","key": "EX-2","fields": {"summary": "second","updated": "2016-04-06T02:40:12.000-0700"}}]}`)
 	})
-	reader := strings.NewReader(testAttachment)
-
-	_, _, err := testClient.Issue.PostAttachment("10000", reader, "")
 
+	loc := time.FixedZone("", -7*60*60)
+	since := time.Date(2016, 4, 6, 2, 30, 45, 0, loc)
+	issues, cursor, _, err := testClient.Issue.SearchUpdatedSince("project = EX", since, nil)
 	if err != nil {
-		t.Errorf("Error expected: %s", err)
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues, got %d", len(issues))
+	}
+	expected := time.Date(2016, 4, 6, 2, 40, 12, 0, loc)
+	if !cursor.Equal(expected) {
+		t.Errorf("Expected cursor %s, got %s", expected, cursor)
 	}
 }
 
-func TestIssueService_PostAttachment_NoAttachment(t *testing.T) {
+func TestIssueService_SearchUpdatedSince_OtherZone(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 1,"issues": [{"id": "10230","key": "EX-1","fields": {"summary": "first","updated": "2016-04-06T09:40:12.000+0000"}}]}`)
 	})
 
-	_, _, err := testClient.Issue.PostAttachment("10000", nil, "attachment")
-
+	loc := time.FixedZone("", -7*60*60)
+	since := time.Date(2016, 4, 6, 2, 30, 0, 0, loc)
+	_, cursor, _, err := testClient.Issue.SearchUpdatedSince("project = EX", since, nil)
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if cursor.Location() != loc || cursor.Format("2006-01-02 15:04") != "2016-04-06 02:40" {
+		t.Errorf("Expected cursor 2016-04-06 02:40 in the zone of since, got %s", cursor)
+	}
 }
 
-func TestIssueService_Search(t *testing.T) {
+func TestIssueService_CountByField(t *testing.T) {
 	setup()
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/search?jql=something&startAt=1&maxResults=40")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 40,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+		if r.URL.Query().Get("maxResults") != "0" {
+			t.Errorf("Expected maxResults 0, got %s", r.URL.Query().Get("maxResults"))
+		}
+		total := 0
+		switch r.URL.Query().Get("jql") {
+		case `(project = EX) AND status = "Open"`:
+			total = 4
+		case `(project = EX) AND status = "In Progress"`:
+			total = 2
+		case `(project = EX) AND status = "Revisé \"QA\""`:
+			total = 1
+		default:
+			t.Errorf("Unexpected jql %q", r.URL.Query().Get("jql"))
+		}
+		fmt.Fprintf(w, `{"startAt":0,"maxResults":0,"total":%d,"issues":[]}`, total)
 	})
 
-	opt := &SearchOptions{StartAt: 1, MaxResults: 40}
-	_, resp, err := testClient.Issue.Search("something", opt)
-
-	if resp == nil {
-		t.Errorf("Response given: %+v", resp)
-	}
+	counts, err := testClient.Issue.CountByField("project = EX", "status", "Open", "In Progress", `Revisé "QA"`)
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
-
-	if resp.StartAt != 1 {
-		t.Errorf("StartAt should populate with 1, %v given", resp.StartAt)
-	}
-	if resp.MaxResults != 40 {
-		t.Errorf("StartAt should populate with 40, %v given", resp.MaxResults)
+	expected := map[string]int{"Open": 4, "In Progress": 2, `Revisé "QA"`: 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected %v, got %v", expected, counts)
 	}
-	if resp.Total != 6 {
-		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+}
+
+func TestIssueService_CountByField_UnknownValues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request for an unsupported field, got %s", r.URL)
+	})
+
+	if _, err := testClient.Issue.CountByField("project = EX", "labels"); err == nil {
+		t.Error("Expected an error for a field without known values")
 	}
 }
 
-func TestIssueService_Search_WithoutPaging(t *testing.T) {
+func TestIssueService_ExportCSV(t *testing.T) {
 	setup()
 	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary","custom":false},{"id":"status","name":"Status","custom":false},{"id":"updated","name":"Updated","custom":false},{"id":"labels","name":"Labels","custom":false},{"id":"customfield_10016","name":"Story Points","custom":true}]`)
+	})
 	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/search?jql=something")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `{"expand": "schema,names","startAt": 0,"maxResults": 50,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+		if fields := r.URL.Query().Get("fields"); fields != "summary,status,updated,labels,customfield_10016" {
+			t.Errorf("Unexpected fields %q", fields)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","fields":{"summary":"First, with comma","status":{"name":"Open"},"updated":"2016-04-06T02:36:53.594-0700","labels":["a","b"],"customfield_10016":3}}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","fields":{"summary":"Second","status":{"name":"Done"},"customfield_10016":null}}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
 	})
 
-	_, resp, err := testClient.Issue.Search("something", nil)
-
-	if resp == nil {
-		t.Errorf("Response given: %+v", resp)
-	}
+	var buf bytes.Buffer
+	_, err := testClient.Issue.ExportCSV("project = EX", []string{"summary", "status", "updated", "labels", "Story Points"}, &buf)
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 
-	if resp.StartAt != 0 {
-		t.Errorf("StartAt should populate with 0, %v given", resp.StartAt)
-	}
-	if resp.MaxResults != 50 {
-		t.Errorf("StartAt should populate with 50, %v given", resp.MaxResults)
+	expected := "Key,Summary,Status,Updated,Labels,Story Points\n" +
+		"EX-1,\"First, with comma\",Open,2016-04-06 02:36,\"a, b\",3\n" +
+		"EX-2,Second,Done,,,\n"
+	if buf.String() != expected {
+		t.Errorf("Expected CSV\n%s\ngot\n%s", expected, buf.String())
 	}
-	if resp.Total != 6 {
-		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+}
+
+func TestIssueService_ExportCSV_UnknownField(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary","custom":false}]`)
+	})
+
+	var buf bytes.Buffer
+	_, err := testClient.Issue.ExportCSV("project = EX", []string{"Story Points"}, &buf)
+	if err == nil {
+		t.Error("Expected an error for an unknown field")
 	}
 }
 
@@ -512,6 +1713,215 @@ func TestIssueService_DoTransition(t *testing.T) {
 	}
 }
 
+func TestIssueService_DoTransitionWithPayload_Properties(t *testing.T) {
+	setup()
+	defer teardown()
+
+	approved := false
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var payload CreateTransitionPayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.Transition.ID != "22" {
+			t.Errorf("Expected transition 22, got %s", payload.Transition.ID)
+		}
+		if len(payload.Properties) != 1 || payload.Properties[0].Key != "approved_by" || payload.Properties[0].Value != "fred" {
+			t.Errorf("Expected property approved_by, got %+v", payload.Properties)
+		}
+		approved = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/123/properties/approved_by", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if !approved {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"key":"approved_by","value":"fred"}`)
+	})
+
+	payload := CreateTransitionPayload{
+		Transition: TransitionPayload{ID: "22"},
+		Properties: []EntityProperty{{Key: "approved_by", Value: "fred"}},
+	}
+	if _, err := testClient.Issue.DoTransitionWithPayload("123", payload); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	property, _, err := testClient.Issue.GetProperty("123", "approved_by")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Value != "fred" {
+		t.Errorf("Expected property value fred, got %+v", property)
+	}
+}
+
+func TestIssueService_TransitionWithComment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		payload := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := map[string]interface{}{
+			"transition": map[string]interface{}{"id": "22"},
+			"fields": map[string]interface{}{
+				"resolution": map[string]interface{}{"name": "Fixed"},
+			},
+			"update": map[string]interface{}{
+				"comment": []interface{}{
+					map[string]interface{}{
+						"add": map[string]interface{}{
+							"body":       "Fixed in master",
+							"visibility": map[string]interface{}{"type": "role", "value": "Developers"},
+						},
+					},
+				},
+			},
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("Expected payload %v, got %v", expected, payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	comment := &Comment{
+		Body:       "Fixed in master",
+		Visibility: CommentVisibility{Type: "role", Value: "Developers"},
+	}
+	fields := map[string]interface{}{
+		"resolution": map[string]string{"name": "Fixed"},
+	}
+	if _, err := testClient.Issue.TransitionWithComment("123", "22", comment, fields); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_TransitionWithComment_NoComment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/transitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var payload CreateTransitionPayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.Update != nil || payload.Fields["resolution"] == nil {
+			t.Errorf("Expected only the fields to be sent, got %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields := map[string]interface{}{"resolution": map[string]string{"name": "Fixed"}}
+	if _, err := testClient.Issue.TransitionWithComment("10000", "5", nil, fields); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DoTransitionByName(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"transitions":[
+				{"id":"11","name":"Start Progress","to":{"id":"3","name":"In Progress"}},
+				{"id":"21","name":"Update","to":{"id":"3","name":"In Progress"}},
+				{"id":"31","name":"Update","to":{"id":"10001","name":"Review"}}
+			]}`)
+			return
+		}
+		testMethod(t, r, "POST")
+		var payload CreateTransitionPayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.Transition.ID != "11" && payload.Transition.ID != "31" {
+			t.Errorf("Unexpected transition %s", payload.Transition.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	transition, _, err := testClient.Issue.DoTransitionByName("123", "start progress")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if transition == nil || transition.ID != "11" {
+		t.Errorf("Expected transition 11, got %+v", transition)
+	}
+
+	transition, _, err = testClient.Issue.DoTransitionByName("123", "31")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if transition == nil || transition.ID != "31" {
+		t.Errorf("Expected transition 31, got %+v", transition)
+	}
+
+	_, _, err = testClient.Issue.DoTransitionByName("123", "Update")
+	if err == nil || !strings.Contains(err.Error(), `21 "Update" (to "In Progress"), 31 "Update" (to "Review")`) {
+		t.Errorf("Expected an ambiguity error listing both transitions, got %v", err)
+	}
+
+	_, _, err = testClient.Issue.DoTransitionByName("123", "Close")
+	if err == nil {
+		t.Error("Expected an error for an unavailable transition")
+	}
+}
+
+func TestIssueService_RevertLastStatusChange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/123?expand=changelog")
+		fmt.Fprint(w, `{"id":"123","key":"EX-1","changelog":{"histories":[
+			{"id":"1","created":"2016-03-16T04:22:37.356+0000","items":[{"field":"status","fieldtype":"jira","from":"1","fromString":"Open","to":"10000","toString":"In Progress"}]},
+			{"id":"2","created":"2016-03-17T04:22:37.356+0000","items":[{"field":"summary","fieldtype":"jira","fromString":"a","toString":"b"},{"field":"status","fieldtype":"jira","from":"10000","fromString":"In Progress","to":"5","toString":"Closed"}]},
+			{"id":"3","created":"2016-03-18T04:22:37.356+0000","items":[{"field":"summary","fieldtype":"jira","fromString":"b","toString":"c"}]}
+		]}}`)
+	})
+
+	raw, err := ioutil.ReadFile("./mocks/transitions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, string(raw))
+			return
+		}
+		testMethod(t, r, "POST")
+		var payload CreateTransitionPayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.Transition.ID != "2" {
+			t.Errorf("Expected transition 2, got %s", payload.Transition.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	transition, _, err := testClient.Issue.RevertLastStatusChange("123")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if transition == nil || transition.To.Name != "In Progress" {
+		t.Errorf("Expected transition to In Progress, got %+v", transition)
+	}
+}
+
+func TestIssueService_RevertLastStatusChange_NoStatusChange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/123", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"123","key":"EX-1","changelog":{"histories":[]}}`)
+	})
+
+	if _, _, err := testClient.Issue.RevertLastStatusChange("123"); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
 func TestIssueFields_TestMarshalJSON_PopulateUnknownsSuccess(t *testing.T) {
 	data := `{
 			"customfield_123":"test",
@@ -594,6 +2004,46 @@ func TestIssueFields_TestMarshalJSON_PopulateUnknownsSuccess(t *testing.T) {
 
 }
 
+func TestIssueFields_GetTimeTracking(t *testing.T) {
+	data := `{
+			"summary":"parent issue",
+			"timespent":3600,
+			"timeoriginalestimate":null,
+			"timeestimate":7200,
+			"aggregatetimespent":10800,
+			"aggregatetimeoriginalestimate":14400,
+			"aggregatetimeestimate":9000
+	}`
+
+	i := new(IssueFields)
+	if err := json.Unmarshal([]byte(data), i); err != nil {
+		t.Fatalf("Expected nil error, recieved %s", err)
+	}
+	if len(i.Unknowns) != 0 {
+		t.Errorf("Expected no unknown fields, recieved %+v", i.Unknowns)
+	}
+
+	raw, aggregate := i.GetTimeTracking()
+	if raw.TimeSpent == nil || *raw.TimeSpent != 3600 {
+		t.Errorf("Expected time spent 3600, recieved %v", raw.TimeSpent)
+	}
+	if raw.OriginalEstimate != nil {
+		t.Errorf("Expected no original estimate, recieved %d", *raw.OriginalEstimate)
+	}
+	if raw.RemainingEstimate == nil || *raw.RemainingEstimate != 7200 {
+		t.Errorf("Expected remaining estimate 7200, recieved %v", raw.RemainingEstimate)
+	}
+	if aggregate.TimeSpent == nil || *aggregate.TimeSpent != 10800 {
+		t.Errorf("Expected aggregate time spent 10800, recieved %v", aggregate.TimeSpent)
+	}
+	if aggregate.OriginalEstimate == nil || *aggregate.OriginalEstimate != 14400 {
+		t.Errorf("Expected aggregate original estimate 14400, recieved %v", aggregate.OriginalEstimate)
+	}
+	if aggregate.RemainingEstimate == nil || *aggregate.RemainingEstimate != 9000 {
+		t.Errorf("Expected aggregate remaining estimate 9000, recieved %v", aggregate.RemainingEstimate)
+	}
+}
+
 func TestIssueFields_MarshalJSON_OmitsEmptyFields(t *testing.T) {
 	i := &IssueFields{
 		Description: "blahblah",
@@ -662,6 +2112,99 @@ func TestIssueFields_MarshalJSON_Success(t *testing.T) {
 	}
 }
 
+func TestIssueFields_Environment_RoundTrip(t *testing.T) {
+	i := &IssueFields{
+		Summary:     "Crash on startup",
+		Environment: "Ubuntu 16.04, Firefox 55",
+	}
+
+	rawdata, err := json.Marshal(i)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	decoded := new(IssueFields)
+	if err := json.Unmarshal(rawdata, decoded); err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if decoded.Environment != "Ubuntu 16.04, Firefox 55" {
+		t.Errorf("Expected environment Ubuntu 16.04, Firefox 55, received %s", decoded.Environment)
+	}
+	if _, ok := decoded.Unknowns["environment"]; ok {
+		t.Error("Expected environment not to be an unknown field")
+	}
+}
+
+func TestIssueFields_DueDate_RoundTrip(t *testing.T) {
+	i := &IssueFields{
+		Summary: "SLA",
+		DueDate: Date(time.Date(2017, time.August, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	rawdata, err := json.Marshal(i)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if !strings.Contains(string(rawdata), `"duedate":"2017-08-01"`) {
+		t.Errorf("Expected the due date to be formatted as date, received %s", rawdata)
+	}
+
+	decoded := new(IssueFields)
+	if err := json.Unmarshal(rawdata, decoded); err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if due := time.Time(decoded.DueDate); !due.Equal(time.Date(2017, time.August, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected due date 2017-08-01, received %s", due)
+	}
+
+	rawdata, err = json.Marshal(&IssueFields{Summary: "no due date"})
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if strings.Contains(string(rawdata), "duedate") {
+		t.Errorf("Expected no due date to be sent, received %s", rawdata)
+	}
+	if err := json.Unmarshal([]byte(`{"duedate":null}`), decoded); err != nil {
+		t.Errorf("Expected nil err for a null due date, received %s", err)
+	}
+}
+
+func TestIssueFields_Duedate_Deprecated(t *testing.T) {
+	rawdata, err := json.Marshal(&IssueFields{Summary: "SLA", Duedate: "2017-08-01"})
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if !strings.Contains(string(rawdata), `"duedate":"2017-08-01"`) {
+		t.Errorf("Expected the deprecated due date to be sent, received %s", rawdata)
+	}
+
+	decoded := new(IssueFields)
+	if err := json.Unmarshal(rawdata, decoded); err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if decoded.Duedate != "2017-08-01" {
+		t.Errorf("Expected the deprecated due date 2017-08-01, received %q", decoded.Duedate)
+	}
+}
+
+func TestIssueFields_UnmarshalJSON_Progress(t *testing.T) {
+	data := `{"summary":"Parent","progress":{"progress":3600,"total":7200,"percent":50},"aggregateprogress":{"progress":5400,"total":21600,"percent":25}}`
+
+	i := new(IssueFields)
+	if err := json.Unmarshal([]byte(data), i); err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+	if expected := (&Progress{Progress: 3600, Total: 7200, Percent: 50}); !reflect.DeepEqual(i.Progress, expected) {
+		t.Errorf("Expected progress %+v, received %+v", expected, i.Progress)
+	}
+	if expected := (&Progress{Progress: 5400, Total: 21600, Percent: 25}); !reflect.DeepEqual(i.AggregateProgress, expected) {
+		t.Errorf("Expected aggregate progress %+v, received %+v", expected, i.AggregateProgress)
+	}
+	if len(i.Unknowns) != 0 {
+		t.Errorf("Expected no unknown fields, received %v", i.Unknowns)
+	}
+}
+
 func TestInitIssueWithMetaAndFields_Success(t *testing.T) {
 	metaProject := MetaProject{
 		Name: "Engineering - Dept",
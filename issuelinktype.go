@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// IssueLinkTypeService handles issue link types for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType
+type IssueLinkTypeService struct {
+	client *Client
+	apiVersion
+}
+
+// issueLinkTypesResult is only a small wrapper around the GetList method
+// to be able to parse the results
+type issueLinkTypesResult struct {
+	IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+}
+
+// GetList gets all of the issue link types from JIRA.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType-getIssueLinkTypes
+func (s *IssueLinkTypeService) GetList() ([]IssueLinkType, *Response, error) {
+	apiEndpoint := "rest/api/2/issueLinkType"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(issueLinkTypesResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.IssueLinkTypes, resp, nil
+}
+
+// Get gets info of a specific issue link type from JIRA.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType-getIssueLinkType
+func (s *IssueLinkTypeService) Get(ID string) (*IssueLinkType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", ID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	linkType := new(IssueLinkType)
+	resp, err := s.client.Do(req, linkType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return linkType, resp, nil
+}
+
+// Create creates an issue link type in JIRA.
+// The user needs JIRA administrator permissions to create issue link types.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType-createIssueLinkType
+func (s *IssueLinkTypeService) Create(name, inward, outward string) (*IssueLinkType, *Response, error) {
+	apiEndpoint := "rest/api/2/issueLinkType"
+	payload := &IssueLinkType{
+		Name:    name,
+		Inward:  inward,
+		Outward: outward,
+	}
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	linkType := new(IssueLinkType)
+	resp, err := s.client.Do(req, linkType)
+	if err != nil {
+		return nil, resp, adminPermissionError(resp, err)
+	}
+	return linkType, resp, nil
+}
+
+// Update updates an issue link type.
+// The issue link type is identified by its ID, all other given values are updated.
+// The user needs JIRA administrator permissions to update issue link types.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType-updateIssueLinkType
+func (s *IssueLinkTypeService) Update(linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkType.ID)
+	req, err := s.client.NewRequest("PUT", s.client.apiEndpoint(s.APIVersion, apiEndpoint), linkType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(IssueLinkType)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, adminPermissionError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Delete deletes the issue link type with the given ID.
+// The user needs JIRA administrator permissions to delete issue link types.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLinkType-deleteIssueLinkType
+func (s *IssueLinkTypeService) Delete(ID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", ID)
+	req, err := s.client.NewRequest("DELETE", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, adminPermissionError(resp, err)
+	}
+	return resp, nil
+}
+
+// adminPermissionError describes err as missing JIRA administrator permissions if the request
+// was forbidden. An *Error is kept, so callers can still inspect it.
+func adminPermissionError(resp *Response, err error) error {
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		return err
+	}
+	if jiraErr, ok := err.(*Error); ok {
+		jiraErr.Message = "JIRA administrator permissions are required for this request"
+		return jiraErr
+	}
+	return fmt.Errorf("JIRA administrator permissions are required for this request: %s", err)
+}
@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueLinkTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"1000","name":"Duplicate","inward":"Duplicated by","outward":"Duplicates","self":"http://www.example.com/jira/rest/api/2//issueLinkType/1000"},
+			{"id":"1010","name":"Blocks","inward":"Blocked by","outward":"Blocks","self":"http://www.example.com/jira/rest/api/2//issueLinkType/1010"}]}`)
+	})
+
+	linkTypes, _, err := testClient.IssueLinkType.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(linkTypes) != 2 {
+		t.Errorf("Expected 2 issue link types, got %d", len(linkTypes))
+	}
+}
+
+func TestIssueLinkTypeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType/1000"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"id":"1000","name":"Duplicate","inward":"Duplicated by","outward":"Duplicates","self":"http://www.example.com/jira/rest/api/2//issueLinkType/1000"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Get("1000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType.Inward != "Duplicated by" {
+		t.Errorf("Expected inward description \"Duplicated by\", got %s", linkType.Inward)
+	}
+	if linkType.Outward != "Duplicates" {
+		t.Errorf("Expected outward description \"Duplicates\", got %s", linkType.Outward)
+	}
+}
+
+func TestIssueLinkTypeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		var payload IssueLinkType
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Name != "Clones" || payload.Inward != "is cloned by" || payload.Outward != "clones" {
+			t.Errorf("Unexpected payload %+v", payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10020","name":"Clones","inward":"is cloned by","outward":"clones","self":"http://www.example.com/jira/rest/api/2//issueLinkType/10020"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Create("Clones", "is cloned by", "clones")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType.ID != "10020" {
+		t.Errorf("Expected id 10020, got %s", linkType.ID)
+	}
+}
+
+func TestIssueLinkTypeService_Create_Forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, resp, err := testClient.IssueLinkType.Create("Clones", "is cloned by", "clones")
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected Status code %d. Given %d", http.StatusForbidden, resp.StatusCode)
+	}
+	if !strings.Contains(err.Error(), "administrator") {
+		t.Errorf("Expected error to mention missing administrator permissions, got %s", err)
+	}
+	if jiraErr, ok := err.(*Error); !ok || jiraErr.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected an *Error with status code 403, got %#v", err)
+	}
+}
+
+func TestIssueLinkTypeService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType/10020"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"id":"10020","name":"Cloners","inward":"is cloned by","outward":"clones","self":"http://www.example.com/jira/rest/api/2//issueLinkType/10020"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Update(&IssueLinkType{ID: "10020", Name: "Cloners", Inward: "is cloned by", Outward: "clones"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType.Name != "Cloners" {
+		t.Errorf("Expected name Cloners, got %s", linkType.Name)
+	}
+}
+
+func TestIssueLinkTypeService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issueLinkType/10020"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := testClient.IssueLinkType.Delete("10020")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected Status code %d. Given %d", http.StatusNoContent, resp.StatusCode)
+	}
+}
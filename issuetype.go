@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// IssueTypeService handles issue types for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issuetype
+type IssueTypeService struct {
+	client *Client
+	apiVersion
+}
+
+// GetAlternatives returns the issue types which can replace the issue type issueTypeID
+// on its issues when it is deleted.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issuetype-getAlternativeIssueTypes
+func (s *IssueTypeService) GetAlternatives(issueTypeID string) ([]IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/alternatives", issueTypeID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueTypes := []IssueType{}
+	resp, err := s.client.Do(req, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// Delete deletes the issue type issueTypeID.
+// The issues of the type are moved to the issue type alternativeID, which has to be one of GetAlternatives.
+// An error is returned without deleting anything if there are no alternatives or alternativeID isn't one of them.
+// If alternativeID is empty, the issue type is deleted without a replacement, JIRA refuses this if it is in use.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issuetype-deleteIssueType
+func (s *IssueTypeService) Delete(issueTypeID, alternativeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueTypeID)
+	if alternativeID != "" {
+		alternatives, resp, err := s.GetAlternatives(issueTypeID)
+		if err != nil {
+			return resp, err
+		}
+		if len(alternatives) == 0 {
+			return resp, fmt.Errorf("Issue type %s has no alternatives to replace it on its issues", issueTypeID)
+		}
+		valid := false
+		for _, alternative := range alternatives {
+			valid = valid || alternative.ID == alternativeID
+		}
+		if !valid {
+			return resp, fmt.Errorf("Issue type %s is not an alternative for issue type %s", alternativeID, issueTypeID)
+		}
+		apiEndpoint += "?alternativeIssueTypeId=" + url.QueryEscape(alternativeID)
+	}
+
+	req, err := s.client.NewRequest("DELETE", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
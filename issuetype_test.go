@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueTypeService_GetAlternatives(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issuetype/10001/alternatives", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issuetype/10001/alternatives")
+		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/issuetype/1","id":"1","name":"Bug","subtask":false},{"self":"http://www.example.com/jira/rest/api/2/issuetype/3","id":"3","name":"Task","subtask":false}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetAlternatives("10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 2 || issueTypes[1].Name != "Task" {
+		t.Errorf("Unexpected issue types %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issuetype/10001/alternatives", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"1","name":"Bug"},{"id":"3","name":"Task"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issuetype/10001?alternativeIssueTypeId=3")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.IssueType.Delete("10001", "3"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.IssueType.Delete("10001", "10002"); err == nil || !strings.Contains(err.Error(), "not an alternative") {
+		t.Errorf("Expected an error for an invalid alternative, got %v", err)
+	}
+}
+
+func TestIssueTypeService_Delete_NoAlternatives(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issuetype/10001/alternatives", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype/10001", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the issue type not to be deleted")
+	})
+
+	if _, err := testClient.IssueType.Delete("10001", "3"); err == nil || !strings.Contains(err.Error(), "no alternatives") {
+		t.Errorf("Expected an error for missing alternatives, got %v", err)
+	}
+}
@@ -5,13 +5,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
 
+const (
+	// APIVersion2 is the version 2 of the JIRA REST API, available on JIRA Server and JIRA Cloud
+	APIVersion2 = "2"
+	// APIVersion3 is the version 3 of the JIRA REST API, only available on JIRA Cloud.
+	// It represents rich text fields (e.g. the description or comment bodies) in the Atlassian
+	// Document Format, which the string fields of this package can't hold. Use IssueService.GetInto,
+	// IssueService.SearchInto or your own types with Client.Do for those.
+	APIVersion3 = "3"
+	// APIVersionLatest is the latest version of the JIRA REST API of the instance,
+	// version 2 on JIRA Server and version 3 on JIRA Cloud
+	APIVersionLatest = "latest"
+)
+
+// apiVersion is embedded by the services of the JIRA REST API ("rest/api/...").
+type apiVersion struct {
+	// APIVersion overrides Client.APIVersion for the requests of the service if set
+	APIVersion string
+}
+
 // A Client manages communication with the JIRA API.
 type Client struct {
 	// HTTP client used to communicate with the API.
@@ -23,6 +45,42 @@ type Client struct {
 	// Session storage if the user authentificate with a Session cookie
 	session *Session
 
+	// Request counters, see Stats
+	stats *clientStats
+
+	// DefaultPageSize is the number of results requested by paginated searches
+	// (IssueService.Search, UserService.FindUsers) when the options don't set MaxResults.
+	// If zero, maxResults isn't sent and the page size is left to JIRA. JIRA caps the page size on the server side
+	// (e.g. 1000 issues per search on JIRA Server, 100 on JIRA Cloud), the effective
+	// value is available from Response.MaxResults. The project list isn't paginated by JIRA
+	// and is not affected.
+	DefaultPageSize int
+
+	// DisableUserCache disables caching the authenticated user in UserService.GetSelf
+	DisableUserCache bool
+
+	// StrictDecoding makes Do fail if a response contains JSON fields the target struct doesn't model.
+	// It helps to detect API changes in tests and CI, but shouldn't be used in production as JIRA
+	// adds fields frequently. Types with their own UnmarshalJSON (e.g. IssueFields) are decoded leniently.
+	StrictDecoding bool
+
+	// APIVersion is the version of the JIRA REST API ("rest/api/<version>/...") used by the services,
+	// one of APIVersion2 (the default), APIVersion3 or APIVersionLatest.
+	// A service can use another version with its APIVersion field, e.g. client.Issue.APIVersion = APIVersion3.
+	// The JIRA Agile API (BoardService, SprintService) and the authentication API are not versioned this way.
+	APIVersion string
+
+	// DryRun makes Do skip write requests (POST, PUT, DELETE, PATCH) and log them to Logger instead.
+	// This affects all methods changing data, e.g. IssueService.Create, IssueService.UpdateIssue,
+	// IssueService.DoTransition, IssueService.AddComment, the Delete methods and the bulk helpers.
+	// The skipped requests get a synthetic 204 No Content response with an empty JSON object as body
+	// and Response.DryRun set, so the returned entities are empty.
+	// Reads always execute, as do the session requests of AuthenticationService.
+	DryRun bool
+
+	// Logger receives the requests skipped by DryRun. If nil, the standard logger of package log is used.
+	Logger *log.Logger
+
 	// Services used for talking to different parts of the JIRA API.
 	Authentication *AuthenticationService
 	Issue          *IssueService
@@ -31,6 +89,21 @@ type Client struct {
 	Sprint         *SprintService
 	User           *UserService
 	Group          *GroupService
+	Version        *VersionService
+	Avatar         *AvatarService
+	IssueLinkType  *IssueLinkTypeService
+	Screen         *ScreenService
+	Filter         *FilterService
+	Dashboard      *DashboardService
+	Field          *FieldService
+	Metadata       *MetadataService
+	Task           *TaskService
+	Admin          *AdminService
+	ServerInfo     *ServerInfoService
+	Worklog        *WorklogService
+	Status         *StatusService
+	Role           *RoleService
+	IssueType      *IssueTypeService
 }
 
 // NewClient returns a new JIRA API client.
@@ -53,6 +126,9 @@ func NewClient(httpClient *http.Client, baseURL string) (*Client, error) {
 	c := &Client{
 		client:  httpClient,
 		baseURL: parsedBaseURL,
+		stats:   new(clientStats),
+
+		APIVersion: APIVersion2,
 	}
 	c.Authentication = &AuthenticationService{client: c}
 	c.Issue = &IssueService{client: c}
@@ -61,6 +137,21 @@ func NewClient(httpClient *http.Client, baseURL string) (*Client, error) {
 	c.Sprint = &SprintService{client: c}
 	c.User = &UserService{client: c}
 	c.Group = &GroupService{client: c}
+	c.Version = &VersionService{client: c}
+	c.Avatar = &AvatarService{client: c}
+	c.IssueLinkType = &IssueLinkTypeService{client: c}
+	c.Screen = &ScreenService{client: c}
+	c.Filter = &FilterService{client: c}
+	c.Dashboard = &DashboardService{client: c}
+	c.Field = &FieldService{client: c}
+	c.Metadata = &MetadataService{client: c, TTL: DefaultMetadataCacheTTL}
+	c.Task = &TaskService{client: c}
+	c.Admin = &AdminService{client: c}
+	c.ServerInfo = &ServerInfoService{client: c}
+	c.Worklog = &WorklogService{client: c}
+	c.Status = &StatusService{client: c}
+	c.Role = &RoleService{client: c}
+	c.IssueType = &IssueTypeService{client: c}
 
 	return c, nil
 }
@@ -132,6 +223,29 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// apiEndpoint rewrites an endpoint of the JIRA REST API version 2 ("rest/api/2/...") to version.
+// If version is empty, Client.APIVersion is used.
+func (c *Client) apiEndpoint(version, endpoint string) string {
+	if version == "" {
+		version = c.APIVersion
+	}
+	if version == "" || version == APIVersion2 {
+		return endpoint
+	}
+	if !strings.HasPrefix(strings.TrimPrefix(endpoint, "/"), "rest/api/2/") {
+		return endpoint
+	}
+	return strings.Replace(endpoint, "rest/api/2/", "rest/api/"+version+"/", 1)
+}
+
+// pageSize returns maxResults if set, the DefaultPageSize of the client otherwise
+func (c *Client) pageSize(maxResults int) int {
+	if maxResults > 0 {
+		return maxResults
+	}
+	return c.DefaultPageSize
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s.  opt
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opt interface{}) (string, error) {
@@ -186,8 +300,14 @@ func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (
 
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
+// If Client.DryRun is set, write requests are logged instead, see DryRun.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	if c.DryRun && isWriteRequest(req) {
+		return c.dryRun(req)
+	}
+
 	httpResp, err := c.client.Do(req)
+	c.stats.countResponse(httpResp)
 	if err != nil {
 		return nil, err
 	}
@@ -202,15 +322,83 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		decoder := json.NewDecoder(httpResp.Body)
+		if c.StrictDecoding {
+			decoder.DisallowUnknownFields()
+		}
+		err = decoder.Decode(v)
 	}
 
 	resp := newResponse(httpResp, v)
 	return resp, err
 }
 
+// isWriteRequest reports if req changes data in JIRA and is skipped in a dry run.
+// Sessions are still created and deleted, so reads keep working.
+func isWriteRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	return !strings.HasSuffix(req.URL.Path, "rest/auth/1/session")
+}
+
+// dryRun logs req instead of sending it and returns a synthetic successful response.
+func (c *Client) dryRun(req *http.Request) (*Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logf := log.Printf
+	if c.Logger != nil {
+		logf = c.Logger.Printf
+	}
+	logf("Dry run: %s %s %s", req.Method, req.URL, bytes.TrimSpace(body))
+
+	httpResp := &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}
+	resp := newResponse(httpResp, nil)
+	resp.DryRun = true
+	return resp, nil
+}
+
+// Error is returned by CheckResponse for responses with a status code outside the 200 range.
+type Error struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// WWWAuthenticate is the challenge sent with a 401 Unauthorized response, if any.
+	// It helps to tell invalid credentials apart from an expired token of an authenticating proxy.
+	WWWAuthenticate string
+	// Message describes the failure if the request is known to need more than the usual
+	// permissions, e.g. JIRA administrator permissions. It is empty otherwise.
+	Message string
+}
+
+// Error returns Message or a generic message, containing the status code.
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s. Status code: %d", e.Message, e.StatusCode)
+	}
+	return fmt.Sprintf("Request failed. Please analyze the request body for more details. Status code: %d", e.StatusCode)
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
+// The returned error is an *Error.
 // The caller is responsible to analyze the response body.
 // The body can contain JSON (if the error is intended) or xml (sometimes JIRA just failes).
 func CheckResponse(r *http.Response) error {
@@ -218,7 +406,10 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	err := fmt.Errorf("Request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
+	err := &Error{
+		StatusCode:      r.StatusCode,
+		WWWAuthenticate: r.Header.Get("WWW-Authenticate"),
+	}
 	return err
 }
 
@@ -236,6 +427,9 @@ type Response struct {
 	StartAt    int
 	MaxResults int
 	Total      int
+
+	// DryRun is set if the request was skipped because of Client.DryRun
+	DryRun bool
 }
 
 func newResponse(r *http.Response, v interface{}) *Response {
@@ -252,6 +446,10 @@ func (r *Response) populatePageValues(v interface{}) {
 		r.StartAt = value.StartAt
 		r.MaxResults = value.MaxResults
 		r.Total = value.Total
+	case *typedSearchResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
 	}
 	return
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -112,6 +113,51 @@ func TestNewClient_WithServices(t *testing.T) {
 	if c.Group == nil {
 		t.Error("No GroupService provided")
 	}
+	if c.Version == nil {
+		t.Error("No VersionService provided")
+	}
+	if c.Avatar == nil {
+		t.Error("No AvatarService provided")
+	}
+	if c.IssueLinkType == nil {
+		t.Error("No IssueLinkTypeService provided")
+	}
+	if c.Screen == nil {
+		t.Error("No ScreenService provided")
+	}
+	if c.Filter == nil {
+		t.Error("No FilterService provided")
+	}
+	if c.Dashboard == nil {
+		t.Error("No DashboardService provided")
+	}
+	if c.Field == nil {
+		t.Error("No FieldService provided")
+	}
+	if c.Metadata == nil {
+		t.Error("No MetadataService provided")
+	}
+	if c.Task == nil {
+		t.Error("No TaskService provided")
+	}
+	if c.Admin == nil {
+		t.Error("No AdminService provided")
+	}
+	if c.ServerInfo == nil {
+		t.Error("No ServerInfoService provided")
+	}
+	if c.Worklog == nil {
+		t.Error("No WorklogService provided")
+	}
+	if c.Status == nil {
+		t.Error("No StatusService provided")
+	}
+	if c.Role == nil {
+		t.Error("No RoleService provided")
+	}
+	if c.IssueType == nil {
+		t.Error("No IssueTypeService provided")
+	}
 }
 
 func TestCheckResponse(t *testing.T) {
@@ -129,6 +175,26 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_Unauthorized(t *testing.T) {
+	r := &http.Response{
+		StatusCode: http.StatusUnauthorized,
+		Header:     http.Header{},
+	}
+	r.Header.Set("WWW-Authenticate", `Bearer realm="example", error="invalid_token"`)
+
+	err := CheckResponse(r)
+	jiraErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected an *Error, got %T", err)
+	}
+	if jiraErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status code 401, got %d", jiraErr.StatusCode)
+	}
+	if jiraErr.WWWAuthenticate != `Bearer realm="example", error="invalid_token"` {
+		t.Errorf("Expected the WWW-Authenticate challenge, got %q", jiraErr.WWWAuthenticate)
+	}
+}
+
 func TestClient_NewRequest(t *testing.T) {
 	c, err := NewClient(nil, testJIRAInstanceURL)
 	if err != nil {
@@ -151,6 +217,49 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_APIVersion(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+
+	testClient.APIVersion = APIVersion3
+	testClient.Issue.APIVersion = APIVersion2
+	if _, _, err := testClient.Field.GetList(); err != nil {
+		t.Errorf("Expected the field list from version 3, got %v", err)
+	}
+	if _, _, err := testClient.Issue.Get("EX-1", nil); err != nil {
+		t.Errorf("Expected the issue from version 2, got %v", err)
+	}
+}
+
+func TestClient_apiEndpoint(t *testing.T) {
+	c, _ := NewClient(nil, testJIRAInstanceURL)
+	if c.APIVersion != APIVersion2 {
+		t.Errorf("Expected default API version 2, got %s", c.APIVersion)
+	}
+
+	tests := []struct {
+		version, endpoint, expected string
+	}{
+		{"", "rest/api/2/issue/EX-1", "rest/api/2/issue/EX-1"},
+		{APIVersion3, "rest/api/2/issue/EX-1", "rest/api/3/issue/EX-1"},
+		{APIVersionLatest, "/rest/api/2/user?username=fred", "/rest/api/latest/user?username=fred"},
+		{APIVersion3, "rest/agile/1.0/board", "rest/agile/1.0/board"},
+	}
+	for _, test := range tests {
+		if endpoint := c.apiEndpoint(test.version, test.endpoint); endpoint != test.expected {
+			t.Errorf("Expected %s for version %q, got %s", test.expected, test.version, endpoint)
+		}
+	}
+}
+
 func TestClient_NewRawRequest(t *testing.T) {
 	c, err := NewClient(nil, testJIRAInstanceURL)
 	if err != nil {
@@ -296,6 +405,63 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestClient_Do_StrictDecoding(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a","B":"b"}`)
+	})
+
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, new(foo)); err != nil {
+		t.Errorf("Expected unknown fields to be ignored by default, got %s", err)
+	}
+
+	testClient.StrictDecoding = true
+	req, _ = testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, new(foo)); err == nil {
+		t.Error("Expected an error for the unknown field B")
+	}
+}
+
+func TestClient_Do_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no write request in a dry run")
+	})
+
+	var logged bytes.Buffer
+	testClient.DryRun = true
+	testClient.Logger = log.New(&logged, "", 0)
+
+	issue, resp, err := testClient.Issue.Get("EX-1", nil)
+	if err != nil || issue.Key != "EX-1" || resp.DryRun {
+		t.Errorf("Expected reads to execute, got %+v, %v", issue, err)
+	}
+
+	_, resp, err = testClient.Issue.AddComment("EX-1", &Comment{Body: "Rescheduled"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resp == nil || !resp.DryRun || resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected a dry run response, got %+v", resp)
+	}
+	expected := "Dry run: POST " + testServer.URL + "/rest/api/2/issue/EX-1/comment {"
+	if !strings.HasPrefix(logged.String(), expected) || !strings.Contains(logged.String(), `"body":"Rescheduled"`) {
+		t.Errorf("Expected log %q with the body, got %q", expected, logged.String())
+	}
+}
+
 func TestClient_Do_HTTPResponse(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"sync"
+	"time"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+const (
+	// DefaultMetadataCacheTTL is the default duration the result of MetadataService.Bootstrap is cached
+	DefaultMetadataCacheTTL = 10 * time.Minute
+)
+
+// MetadataService bundles the global lists of the JIRA instance
+// (statuses, priorities, issue types, ...) which are typically required on startup.
+type MetadataService struct {
+	client *Client
+	apiVersion
+
+	// TTL is the duration the result of Bootstrap is cached. A zero value disables caching.
+	TTL time.Duration
+
+	mu       sync.Mutex
+	metadata *Metadata
+	expires  time.Time
+}
+
+// Metadata contains the global lists of a JIRA instance
+type Metadata struct {
+	Statuses       []Status
+	Priorities     []Priority
+	IssueTypes     []IssueType
+	Resolutions    []Resolution
+	IssueLinkTypes []IssueLinkType
+	Fields         []Field
+}
+
+// Bootstrap fetches the statuses, priorities, issue types, resolutions, issue link types
+// and fields of the JIRA instance concurrently and returns them in a single struct.
+// The result is cached for TTL. Use Invalidate to force a refresh.
+func (s *MetadataService) Bootstrap() (*Metadata, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.metadata != nil && time.Now().Before(s.expires) {
+		return s.metadata, nil
+	}
+
+	metadata := new(Metadata)
+	requests := []struct {
+		apiEndpoint string
+		v           interface{}
+	}{
+		{"rest/api/2/status", &metadata.Statuses},
+		{"rest/api/2/priority", &metadata.Priorities},
+		{"rest/api/2/issuetype", &metadata.IssueTypes},
+		{"rest/api/2/resolution", &metadata.Resolutions},
+		{"rest/api/2/field", &metadata.Fields},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(requests)+1)
+	for _, r := range requests {
+		wg.Add(1)
+		go func(apiEndpoint string, v interface{}) {
+			defer wg.Done()
+			req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if _, err := s.client.Do(req, v); err != nil {
+				errs <- err
+			}
+		}(r.apiEndpoint, r.v)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		linkTypes, _, err := s.client.IssueLinkType.GetList()
+		if err != nil {
+			errs <- err
+			return
+		}
+		metadata.IssueLinkTypes = linkTypes
+	}()
+
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	if s.TTL > 0 {
+		s.metadata = metadata
+		s.expires = time.Now().Add(s.TTL)
+	}
+	return metadata, nil
+}
+
+// Invalidate drops the cached result of Bootstrap.
+func (s *MetadataService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metadata = nil
+}
+
+// TranslateCustomFieldKeys returns a copy of fields with the keys of custom fields
+// (e.g. "customfield_10016") replaced by their names (e.g. "Story Points").
+// It is meant to post-process maps like IssueFields.Unknowns and uses the fields of Bootstrap.
+// If several custom fields share the same name, their keys are kept to avoid collisions.
+// Keys which aren't custom fields are copied unchanged.
+func (s *MetadataService) TranslateCustomFieldKeys(fields tcontainer.MarshalMap) (tcontainer.MarshalMap, error) {
+	metadata, err := s.Bootstrap()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	count := map[string]int{}
+	for _, field := range metadata.Fields {
+		if field.Custom {
+			names[field.ID] = field.Name
+			count[field.Name]++
+		}
+	}
+
+	translated := make(tcontainer.MarshalMap, len(fields))
+	for key, value := range fields {
+		if name, ok := names[key]; ok && count[name] == 1 {
+			if _, taken := fields[name]; !taken {
+				key = name
+			}
+		}
+		translated[key] = value
+	}
+	return translated, nil
+}
@@ -0,0 +1,139 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+type metadataCalls struct {
+	sync.Mutex
+	calls map[string]int
+}
+
+func (c *metadataCalls) count(endpoint string) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.calls[endpoint]
+}
+
+func setupMetadataHandlers(t *testing.T) *metadataCalls {
+	calls := &metadataCalls{calls: make(map[string]int)}
+	responses := map[string]string{
+		"/rest/api/2/status":        `[{"self":"http://www.example.com/jira/rest/api/2/status/10000","description":"The issue is currently being worked on.","iconUrl":"http://www.example.com/jira/images/icons/progress.gif","name":"In Progress","id":"10000","statusCategory":{"self":"http://www.example.com/jira/rest/api/2/statuscategory/1","id":1,"key":"in-flight","colorName":"yellow","name":"In Progress"}}]`,
+		"/rest/api/2/priority":      `[{"self":"http://www.example.com/jira/rest/api/2/priority/3","iconUrl":"http://www.example.com/jira/images/icons/priorities/major.png","name":"Major","id":"3"}]`,
+		"/rest/api/2/issuetype":     `[{"self":"http://www.example.com/jira/rest/api/2/issuetype/1","id":"1","description":"A problem with the software.","iconUrl":"http://www.example.com/jira/images/icons/issuetypes/bug.png","name":"Bug","subtask":false}]`,
+		"/rest/api/2/resolution":    `[{"self":"http://www.example.com/jira/rest/api/2/resolution/1","id":"1","description":"A fix for this issue is checked into the tree and tested.","name":"Fixed"}]`,
+		"/rest/api/2/issueLinkType": `{"issueLinkTypes":[{"id":"1000","name":"Duplicate","inward":"Duplicated by","outward":"Duplicates"}]}`,
+		"/rest/api/2/field":         `[{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}}]`,
+	}
+	for endpoint, response := range responses {
+		endpoint, response := endpoint, response
+		testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			calls.Lock()
+			calls.calls[endpoint]++
+			calls.Unlock()
+			fmt.Fprint(w, response)
+		})
+	}
+	return calls
+}
+
+func TestMetadataService_Bootstrap(t *testing.T) {
+	setup()
+	defer teardown()
+	setupMetadataHandlers(t)
+
+	metadata, err := testClient.Metadata.Bootstrap()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(metadata.Statuses) != 1 || metadata.Statuses[0].StatusCategory.Key != "in-flight" {
+		t.Errorf("Unexpected statuses %+v", metadata.Statuses)
+	}
+	if len(metadata.Priorities) != 1 {
+		t.Errorf("Expected 1 priority, got %d", len(metadata.Priorities))
+	}
+	if len(metadata.IssueTypes) != 1 {
+		t.Errorf("Expected 1 issue type, got %d", len(metadata.IssueTypes))
+	}
+	if len(metadata.Resolutions) != 1 {
+		t.Errorf("Expected 1 resolution, got %d", len(metadata.Resolutions))
+	}
+	if len(metadata.IssueLinkTypes) != 1 {
+		t.Errorf("Expected 1 issue link type, got %d", len(metadata.IssueLinkTypes))
+	}
+	if len(metadata.Fields) != 1 {
+		t.Errorf("Expected 1 field, got %d", len(metadata.Fields))
+	}
+}
+
+func TestMetadataService_Bootstrap_Cached(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := setupMetadataHandlers(t)
+
+	for i := 0; i < 2; i++ {
+		if _, err := testClient.Metadata.Bootstrap(); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if n := calls.count("/rest/api/2/status"); n != 1 {
+		t.Errorf("Expected statuses to be requested once, got %d", n)
+	}
+
+	testClient.Metadata.Invalidate()
+	if _, err := testClient.Metadata.Bootstrap(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n := calls.count("/rest/api/2/status"); n != 2 {
+		t.Errorf("Expected statuses to be requested again after Invalidate, got %d", n)
+	}
+}
+
+func TestMetadataService_Bootstrap_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if _, err := testClient.Metadata.Bootstrap(); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestMetadataService_TranslateCustomFieldKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMetadata(`[{"id":"customfield_10016","name":"Story Points","custom":true},{"id":"customfield_10020","name":"Team","custom":true},{"id":"customfield_10021","name":"Team","custom":true},{"id":"summary","name":"Summary","custom":false}]`)
+
+	fields := tcontainer.MarshalMap{
+		"customfield_10016": 3.0,
+		"customfield_10020": "A",
+		"customfield_10021": "B",
+		"customfield_99999": "unknown",
+	}
+	translated, err := testClient.Metadata.TranslateCustomFieldKeys(fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	expected := tcontainer.MarshalMap{
+		"Story Points":      3.0,
+		"customfield_10020": "A",
+		"customfield_10021": "B",
+		"customfield_99999": "unknown",
+	}
+	if !reflect.DeepEqual(translated, expected) {
+		t.Errorf("Expected %v, got %v", expected, translated)
+	}
+	if _, ok := fields["customfield_10016"]; !ok {
+		t.Error("Expected the given map not to be modified")
+	}
+}
@@ -41,12 +41,53 @@ type MetaIssueType struct {
 	Fields      tcontainer.MarshalMap `json:"fields,omitempty"`
 }
 
-// GetCreateMeta makes the api call to get the meta information required to create a ticket
+// EditMetaInfo contains information about fields and their attributed to edit a ticket.
+//
+// Note: Fields is a map for the same reason as in MetaIssueType.
+type EditMetaInfo struct {
+	Fields tcontainer.MarshalMap `json:"fields,omitempty"`
+}
+
+// CreateMetaOptions specifies the optional parameters of the paginated create meta methods
+type CreateMetaOptions struct {
+	// StartAt: The starting index of the returned values. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of values to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// CreateMetaIssueTypes represents one page of the issue types available to create issues in a project
+type CreateMetaIssueTypes struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IssueTypes []*MetaIssueType `json:"issueTypes"`
+}
+
+// CreateMetaFields represents one page of the fields available to create issues of an issue type.
+// Each field carries its ID in "fieldId" next to the attributes known from MetaIssueType.Fields.
+type CreateMetaFields struct {
+	StartAt    int                     `json:"startAt"`
+	MaxResults int                     `json:"maxResults"`
+	Total      int                     `json:"total"`
+	Fields     []tcontainer.MarshalMap `json:"fields"`
+}
+
+// GetCreateMeta makes the api call to get the meta information required to create a ticket.
+// On JIRA Cloud the monolithic createmeta endpoint is deprecated, there the information is
+// collected from the granular endpoints (see GetCreateMetaIssueTypes and GetCreateMetaFields).
 func (s *IssueService) GetCreateMeta(projectkey string) (*CreateMetaInfo, *Response, error) {
+	cloud, err := s.client.ServerInfo.IsCloud()
+	if err != nil {
+		return nil, nil, err
+	}
+	if cloud {
+		return s.getCreateMetaGranular(projectkey)
+	}
 
 	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/createmeta?projectKeys=%s&expand=projects.issuetypes.fields", projectkey)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,6 +102,250 @@ func (s *IssueService) GetCreateMeta(projectkey string) (*CreateMetaInfo, *Respo
 	return meta, resp, nil
 }
 
+// getCreateMetaGranular builds the CreateMetaInfo of a single project from the granular create meta endpoints
+func (s *IssueService) getCreateMetaGranular(projectkey string) (*CreateMetaInfo, *Response, error) {
+	project, resp, err := s.client.Project.Get(projectkey)
+	if err != nil {
+		return nil, resp, err
+	}
+	metaProject := &MetaProject{
+		Self: project.Self,
+		Id:   project.ID,
+		Key:  project.Key,
+		Name: project.Name,
+	}
+
+	options := &CreateMetaOptions{}
+	for {
+		page, resp, err := s.GetCreateMetaIssueTypes(projectkey, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		metaProject.IssueTypes = append(metaProject.IssueTypes, page.IssueTypes...)
+		options.StartAt += len(page.IssueTypes)
+		if len(page.IssueTypes) == 0 || options.StartAt >= page.Total {
+			break
+		}
+	}
+
+	for _, issueType := range metaProject.IssueTypes {
+		issueType.Fields = tcontainer.NewMarshalMap()
+		options := &CreateMetaOptions{}
+		for {
+			page, resp, err := s.GetCreateMetaFields(projectkey, issueType.Id, options)
+			if err != nil {
+				return nil, resp, err
+			}
+			for _, field := range page.Fields {
+				if id, ok := field["fieldId"].(string); ok {
+					issueType.Fields[id] = map[string]interface{}(field)
+				}
+			}
+			options.StartAt += len(page.Fields)
+			if len(page.Fields) == 0 || options.StartAt >= page.Total {
+				break
+			}
+		}
+	}
+
+	return &CreateMetaInfo{Projects: []*MetaProject{metaProject}}, resp, nil
+}
+
+// GetCreateMetaIssueTypes returns a page of the issue types which can be used to create issues in the project projectIDOrKey.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-createmeta-projectIdOrKey-issuetypes-get
+func (s *IssueService) GetCreateMetaIssueTypes(projectIDOrKey string, options *CreateMetaOptions) (*CreateMetaIssueTypes, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes", projectIDOrKey)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(CreateMetaIssueTypes)
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+// GetCreateMetaFields returns a page of the fields which can be set when creating an issue of type issueTypeID in the project projectIDOrKey.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-createmeta-projectIdOrKey-issuetypes-issueTypeId-get
+func (s *IssueService) GetCreateMetaFields(projectIDOrKey, issueTypeID string, options *CreateMetaOptions) (*CreateMetaFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes/%s", projectIDOrKey, issueTypeID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(CreateMetaFields)
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+// GetEditMeta makes the api call to get the meta information required to edit the issue with the given issueID.
+// The same information is available inline with a single Get call by setting Expand to "editmeta" in the GetQueryOptions.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getEditIssueMeta
+func (s *IssueService) GetEditMeta(issueID string) (*EditMetaInfo, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/editmeta", issueID)
+
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := new(EditMetaInfo)
+	resp, err := s.client.Do(req, meta)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return meta, resp, nil
+}
+
+// EditableField represents a field which can be edited on all issues passed to IssueService.GetCommonEditMeta
+type EditableField struct {
+	ID   string
+	Name string
+	// Operations contains the operations (e.g. "set", "add" and "remove") supported for the field by all issues
+	Operations []string
+	// AllowedValues contains the values allowed by all issues. It is nil if the field accepts any value.
+	AllowedValues []map[string]interface{}
+}
+
+// GetCommonEditMeta requests the edit meta information of all issueIDs with at most concurrency requests
+// running in parallel and returns the fields that are editable on every issue, keyed by field ID.
+// Only operations and allowed values supported by all issues are kept, which makes the result
+// suitable to plan a bulk edit. A concurrency below 1 falls back to DefaultConcurrency.
+// Allowed values are compared by their "id", "value" or "name", whichever is present first.
+func (s *IssueService) GetCommonEditMeta(issueIDs []string, concurrency int) (map[string]*EditableField, error) {
+	metas := make([]*EditMetaInfo, len(issueIDs))
+	errs := make([]error, len(issueIDs))
+	runConcurrently(len(issueIDs), concurrency, func(i int) {
+		_, errs[i] = s.client.doWithBackoff(func() (*Response, error) {
+			var resp *Response
+			var err error
+			metas[i], resp, err = s.GetEditMeta(issueIDs[i])
+			return resp, err
+		})
+	})
+
+	common := map[string]*EditableField{}
+	for i, meta := range metas {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		fields := editableFields(meta)
+		if i == 0 {
+			common = fields
+			continue
+		}
+		for id, field := range common {
+			other, ok := fields[id]
+			if !ok {
+				delete(common, id)
+				continue
+			}
+			field.Operations = intersectOperations(field.Operations, other.Operations)
+			if len(field.Operations) == 0 {
+				delete(common, id)
+				continue
+			}
+			switch {
+			case other.AllowedValues == nil:
+			case field.AllowedValues == nil:
+				field.AllowedValues = other.AllowedValues
+			default:
+				field.AllowedValues = intersectAllowedValues(field.AllowedValues, other.AllowedValues)
+			}
+		}
+	}
+	return common, nil
+}
+
+// editableFields converts the fields of meta into EditableFields
+func editableFields(meta *EditMetaInfo) map[string]*EditableField {
+	fields := map[string]*EditableField{}
+	for id, value := range meta.Fields {
+		raw, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		field := &EditableField{ID: id}
+		field.Name, _ = raw["name"].(string)
+		if operations, ok := raw["operations"].([]interface{}); ok {
+			for _, operation := range operations {
+				if operation, ok := operation.(string); ok {
+					field.Operations = append(field.Operations, operation)
+				}
+			}
+		}
+		if values, ok := raw["allowedValues"].([]interface{}); ok {
+			field.AllowedValues = []map[string]interface{}{}
+			for _, v := range values {
+				if v, ok := v.(map[string]interface{}); ok {
+					field.AllowedValues = append(field.AllowedValues, v)
+				}
+			}
+		}
+		fields[id] = field
+	}
+	return fields
+}
+
+// intersectOperations returns the operations of a which are also in b
+func intersectOperations(a, b []string) []string {
+	var result []string
+	for _, operation := range a {
+		for _, other := range b {
+			if operation == other {
+				result = append(result, operation)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// intersectAllowedValues returns the values of a which are also in b
+func intersectAllowedValues(a, b []map[string]interface{}) []map[string]interface{} {
+	keys := map[string]bool{}
+	for _, v := range b {
+		keys[allowedValueKey(v)] = true
+	}
+	result := []map[string]interface{}{}
+	for _, v := range a {
+		if keys[allowedValueKey(v)] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// allowedValueKey identifies an allowed value of a field
+func allowedValueKey(v map[string]interface{}) string {
+	for _, key := range []string{"id", "value", "name"} {
+		if value, ok := v[key]; ok {
+			return fmt.Sprint(value)
+		}
+	}
+	return fmt.Sprint(v)
+}
+
 // GetProjectWithName returns a project with "name" from the meta information recieved. If not found, this returns nil.
 // The comparision of the name is case insensitive.
 func (m *CreateMetaInfo) GetProjectWithName(name string) *MetaProject {
@@ -3,12 +3,14 @@ package jira
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
 func TestIssueService_GetCreateMeta_Success(t *testing.T) {
 	setup()
 	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
 
 	testAPIEndpoint := "/rest/api/2/issue/createmeta"
 
@@ -378,6 +380,179 @@ func TestIssueService_GetCreateMeta_Success(t *testing.T) {
 
 }
 
+func TestIssueService_GetCreateMeta_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeCloud)
+	testMux.HandleFunc("/rest/api/2/project/SPN", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"self":"https://my.jira.com/rest/api/2/project/11300","id":"11300","key":"SPN","name":"Super Project Name"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/SPN/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issueTypes":[{"id":"1","name":"Bug","subtask":false}]}`)
+			return
+		}
+		testRequestURL(t, r, "/rest/api/2/issue/createmeta/SPN/issuetypes?startAt=1")
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issueTypes":[{"id":"5","name":"Sub-task","subtask":true}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/SPN/issuetypes/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.String() {
+		case "/rest/api/2/issue/createmeta/SPN/issuetypes/1":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"fields":[{"fieldId":"summary","key":"summary","name":"Summary","required":true,"schema":{"type":"string","system":"summary"}}]}`)
+		case "/rest/api/2/issue/createmeta/SPN/issuetypes/1?startAt=1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"fields":[{"fieldId":"labels","key":"labels","name":"Labels","required":false,"schema":{"type":"array","items":"string","system":"labels"}}]}`)
+		case "/rest/api/2/issue/createmeta/SPN/issuetypes/5":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"fields":[{"fieldId":"parent","key":"parent","name":"Parent","required":true}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	meta, _, err := testClient.Issue.GetCreateMeta("SPN")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	project := meta.GetProjectWithKey("SPN")
+	if project == nil || project.Name != "Super Project Name" {
+		t.Fatalf("Expected project SPN, got %+v", meta.Projects)
+	}
+	if len(project.IssueTypes) != 2 {
+		t.Fatalf("Expected 2 issue types, got %d", len(project.IssueTypes))
+	}
+
+	bug := project.GetIssueTypeWithName("Bug")
+	if len(bug.Fields) != 2 {
+		t.Errorf("Expected 2 fields for Bug, got %d", len(bug.Fields))
+	}
+	mandatory, err := bug.GetMandatoryFields()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if mandatory["Summary"] != "summary" || len(mandatory) != 1 {
+		t.Errorf("Expected Summary to be the only mandatory field, got %v", mandatory)
+	}
+}
+
+func TestIssueService_GetEditMeta_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/PROJ-9001/editmeta"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		fmt.Fprint(w, `{
+	"fields": {
+		"summary": {
+			"required": true,
+			"schema": {
+				"type": "string",
+				"system": "summary"
+			},
+			"name": "Summary",
+			"hasDefaultValue": false,
+			"operations": [
+				"set"
+			]
+		},
+		"labels": {
+			"required": false,
+			"schema": {
+				"type": "array",
+				"items": "string",
+				"system": "labels"
+			},
+			"name": "Labels",
+			"autoCompleteUrl": "https://my.jira.com/rest/api/1.0/labels/suggest?query=",
+			"hasDefaultValue": false,
+			"operations": [
+				"add",
+				"set",
+				"remove"
+			]
+		}
+	}
+}`)
+	})
+
+	editMeta, _, err := testClient.Issue.GetEditMeta("PROJ-9001")
+	if err != nil {
+		t.Errorf("Expected nil error but got %s", err)
+	}
+
+	if len(editMeta.Fields) != 2 {
+		t.Errorf("Expected 2 editable fields, got %d", len(editMeta.Fields))
+	}
+
+	name, err := editMeta.Fields.String("labels/name")
+	if err != nil {
+		t.Errorf("Expected nil error but got %s", err)
+	}
+	if name != "Labels" {
+		t.Errorf("Expected field name %s, got %s", "Labels", name)
+	}
+}
+
+func TestIssueService_GetEditMeta_ContextPath(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/jira/rest/api/2/issue/PROJ-9001/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"fields":{}}`)
+	})
+
+	client, _ := NewClient(nil, testServer.URL+"/jira/")
+	if _, _, err := client.Issue.GetEditMeta("PROJ-9001"); err != nil {
+		t.Errorf("Expected the edit meta below the context path, got %v", err)
+	}
+}
+
+func TestIssueService_GetCommonEditMeta(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"fields":{
+			"summary":{"name":"Summary","operations":["set"]},
+			"labels":{"name":"Labels","operations":["add","set","remove"]},
+			"priority":{"name":"Priority","operations":["set"],"allowedValues":[{"id":"1","name":"High"},{"id":"2","name":"Low"}]},
+			"customfield_10000":{"name":"Team","operations":["set"]}
+		}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"fields":{
+			"summary":{"name":"Summary","operations":["set"]},
+			"labels":{"name":"Labels","operations":["set","add"]},
+			"priority":{"name":"Priority","operations":["set"],"allowedValues":[{"id":"2","name":"Low"},{"id":"3","name":"Lowest"}]}
+		}}`)
+	})
+
+	fields, err := testClient.Issue.GetCommonEditMeta([]string{"EX-1", "EX-2"}, 2)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 3 {
+		t.Errorf("Expected 3 common fields, got %d", len(fields))
+	}
+	if _, ok := fields["customfield_10000"]; ok {
+		t.Error("Expected customfield_10000 not to be editable on all issues")
+	}
+	if ops := fields["labels"].Operations; !reflect.DeepEqual(ops, []string{"add", "set"}) {
+		t.Errorf("Expected operations [add set] for labels, got %v", ops)
+	}
+	if fields["summary"].AllowedValues != nil {
+		t.Errorf("Expected any value for summary, got %v", fields["summary"].AllowedValues)
+	}
+	if values := fields["priority"].AllowedValues; len(values) != 1 || values[0]["name"] != "Low" {
+		t.Errorf("Expected Low to be the only allowed priority, got %v", values)
+	}
+}
+
 func TestMetaIssueType_GetMandatoryFields(t *testing.T) {
 	data := make(map[string]interface{})
 
@@ -0,0 +1,152 @@
+package jira
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OAuth1Transport is an http.RoundTripper that signs every request with OAuth 1.0a (RSA-SHA1),
+// as used by application links of JIRA Server.
+// Use it to create the http.Client passed to NewClient:
+//
+//	transport := &jira.OAuth1Transport{ConsumerKey: "key", PrivateKey: key, AccessToken: "token"}
+//	client, err := jira.NewClient(transport.Client(), "https://your.jira-instance.com/")
+//
+// The access token has to be obtained beforehand, see the README for the token exchange.
+type OAuth1Transport struct {
+	// ConsumerKey is the consumer key of the application link
+	ConsumerKey string
+	// PrivateKey is the private key matching the public key configured in the application link
+	PrivateKey *rsa.PrivateKey
+	// AccessToken is the OAuth access token of the user
+	AccessToken string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+// A fresh nonce and timestamp are used for every request.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     t.ConsumerKey,
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_token":            t.AccessToken,
+		"oauth_version":          "1.0",
+	}
+
+	hashed := sha1.Sum([]byte(oauth1SignatureBase(req.Method, req.URL, params)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, t.PrivateKey, crypto.SHA1, hashed[:])
+	if err != nil {
+		return nil, err
+	}
+	params["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	header := make([]string, len(keys))
+	for i, key := range keys {
+		header[i] = fmt.Sprintf("%s=\"%s\"", key, oauth1Escape(params[key]))
+	}
+
+	// RoundTrippers must not modify the given request
+	req2 := cloneRequest(req)
+	req2.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests signed with OAuth 1.0a.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// oauth1SignatureBase builds the signature base string of a request
+// from its method, URL (incl. query parameters) and the oauth parameters.
+// JIRA only accepts JSON bodies, so form encoded body parameters are not taken into account.
+func oauth1SignatureBase(method string, u *url.URL, oauthParams map[string]string) string {
+	var pairs [][2]string
+	for key, values := range u.Query() {
+		for _, value := range values {
+			pairs = append(pairs, [2]string{oauth1Escape(key), oauth1Escape(value)})
+		}
+	}
+	for key, value := range oauthParams {
+		pairs = append(pairs, [2]string{oauth1Escape(key), oauth1Escape(value)})
+	}
+	// Parameters are sorted by their encoded name first and by their encoded value second
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	params := make([]string, len(pairs))
+	for i, pair := range pairs {
+		params[i] = pair[0] + "=" + pair[1]
+	}
+
+	host := strings.ToLower(u.Host)
+	if (u.Scheme == "http" && strings.HasSuffix(host, ":80")) || (u.Scheme == "https" && strings.HasSuffix(host, ":443")) {
+		host = host[:strings.LastIndex(host, ":")]
+	}
+	baseURL := strings.ToLower(u.Scheme) + "://" + host + u.EscapedPath()
+
+	return strings.ToUpper(method) + "&" + oauth1Escape(baseURL) + "&" + oauth1Escape(strings.Join(params, "&"))
+}
+
+// oauth1Escape percent encodes s as specified by RFC 5849, section 3.6
+func oauth1Escape(s string) string {
+	var buf bytes.Buffer
+	for _, b := range []byte(s) {
+		if ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || ('0' <= b && b <= '9') || b == '-' || b == '.' || b == '_' || b == '~' {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+// cloneRequest returns a clone of the provided *http.Request.
+// The clone is a shallow copy of the struct and its Header map.
+func cloneRequest(r *http.Request) *http.Request {
+	// shallow copy of the struct
+	r2 := new(http.Request)
+	*r2 = *r
+	// deep copy of the Header
+	r2.Header = make(http.Header, len(r.Header))
+	for k, s := range r.Header {
+		r2.Header[k] = append([]string(nil), s...)
+	}
+	return r2
+}
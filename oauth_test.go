@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOAuth1Transport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if !strings.HasPrefix(header, "OAuth ") {
+			t.Errorf("Expected an OAuth authorization header, got %q", header)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		params := map[string]string{}
+		for _, param := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+			parts := strings.SplitN(param, "=", 2)
+			value, _ := url.QueryUnescape(strings.Trim(parts[1], `"`))
+			params[parts[0]] = value
+		}
+		if params["oauth_consumer_key"] != "consumer" || params["oauth_token"] != "token" {
+			t.Errorf("Expected consumer key and token, got %v", params)
+		}
+		if params["oauth_nonce"] == "" || params["oauth_timestamp"] == "" {
+			t.Errorf("Expected nonce and timestamp, got %v", params)
+		}
+
+		signature, _ := base64.StdEncoding.DecodeString(params["oauth_signature"])
+		delete(params, "oauth_signature")
+		u := *r.URL
+		u.Scheme = "http"
+		u.Host = r.Host
+		hashed := sha1.Sum([]byte(oauth1SignatureBase(r.Method, &u, params)))
+		if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, hashed[:], signature); err != nil {
+			t.Errorf("Invalid signature: %s", err)
+		}
+		w.Write([]byte(`{"version":"7.4.0"}`))
+	})
+
+	transport := &OAuth1Transport{ConsumerKey: "consumer", PrivateKey: key, AccessToken: "token"}
+	client, _ := NewClient(transport.Client(), testServer.URL)
+	if _, _, err := client.ServerInfo.Get(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestOAuth1SignatureBase(t *testing.T) {
+	// Example of RFC 5849, section 3.4.1.1 without body parameters
+	u, _ := url.Parse("http://EXAMPLE.COM:80/request?b5=%3D%253D&a3=a&c%40=&a2=r%20b")
+	params := map[string]string{
+		"oauth_consumer_key":     "9djdj82h48djs9d2",
+		"oauth_token":            "kkk9d7dh3k39sjv7",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "137131201",
+		"oauth_nonce":            "7d8f3e4a",
+	}
+
+	expected := "GET&http%3A%2F%2Fexample.com%2Frequest&a2%3Dr%2520b%26a3%3Da%26b5%3D%253D%25253D%26c%2540%3D%26" +
+		"oauth_consumer_key%3D9djdj82h48djs9d2%26oauth_nonce%3D7d8f3e4a%26oauth_signature_method%3DHMAC-SHA1%26" +
+		"oauth_timestamp%3D137131201%26oauth_token%3Dkkk9d7dh3k39sjv7"
+	if base := oauth1SignatureBase("GET", u, params); base != expected {
+		t.Errorf("Expected signature base\n%s\ngot\n%s", expected, base)
+	}
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"strconv"
 )
 
 // ProjectService handles projects for the JIRA instance / API.
@@ -9,6 +10,7 @@ import (
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project
 type ProjectService struct {
 	client *Client
+	apiVersion
 }
 
 // ProjectList represent a list of Projects
@@ -86,7 +88,26 @@ type ProjectComponent struct {
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllProjects
 func (s *ProjectService) GetList() (*ProjectList, *Response, error) {
 	apiEndpoint := "rest/api/2/project"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectList := new(ProjectList)
+	resp, err := s.client.Do(req, projectList)
+	if err != nil {
+		return nil, resp, err
+	}
+	return projectList, resp, nil
+}
+
+// GetRecent returns the count most recently accessed projects of the current user, most recent first.
+// JIRA returns at most 20 recent projects.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllProjects
+func (s *ProjectService) GetRecent(count int) (*ProjectList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project?recent=%d", count)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -106,7 +127,7 @@ func (s *ProjectService) GetList() (*ProjectList, *Response, error) {
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
 func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s", projectID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -118,3 +139,143 @@ func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
 	}
 	return project, resp, nil
 }
+
+// GetIssueTypes returns the issue types which are valid for the project with the given projectID.
+// The list is based on the issue type scheme associated with the project,
+// so unlike the global issue type list it doesn't contain types which can't be used in this project.
+// projectID can be a project id or a project key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
+func (s *ProjectService) GetIssueTypes(projectID string) ([]IssueType, *Response, error) {
+	project, resp, err := s.Get(projectID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return project.IssueTypes, resp, nil
+}
+
+// ProjectIssueTypeStatuses represents the statuses an issue type can have in a project
+type ProjectIssueTypeStatuses struct {
+	Self     string   `json:"self,omitempty" structs:"self,omitempty"`
+	ID       string   `json:"id,omitempty" structs:"id,omitempty"`
+	Name     string   `json:"name,omitempty" structs:"name,omitempty"`
+	Subtask  bool     `json:"subtask,omitempty" structs:"subtask,omitempty"`
+	Statuses []Status `json:"statuses,omitempty" structs:"statuses,omitempty"`
+}
+
+// GetStatuses returns, per issue type, the statuses used by the workflows of the project with the given projectID.
+// Unlike the global status list it only contains statuses which are used in this project.
+// projectID can be a project id or a project key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllStatuses
+func (s *ProjectService) GetStatuses(projectID string) ([]ProjectIssueTypeStatuses, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/statuses", projectID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []ProjectIssueTypeStatuses{}
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return statuses, resp, nil
+}
+
+// ProjectScheme represents a scheme (e.g. permission, notification or workflow scheme) associated with a project
+type ProjectScheme struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	ID          int64  `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// GetPermissionScheme returns the permission scheme associated with the project with the given projectID.
+// projectID can be a project id or a project key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-getAssignedPermissionScheme
+func (s *ProjectService) GetPermissionScheme(projectID string) (*ProjectScheme, *Response, error) {
+	return s.getScheme(fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectID))
+}
+
+// SetPermissionScheme assigns the permission scheme with the given schemeID to the project with the given projectID
+// and returns the new association. projectID can be a project id or a project key.
+// The user needs JIRA administrator permissions to change the permission scheme.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *ProjectService) SetPermissionScheme(projectID string, schemeID int) (*ProjectScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectID)
+	payload := struct {
+		ID int `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequest("PUT", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(ProjectScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, adminPermissionError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetNotificationScheme returns the notification scheme associated with the project with the given projectID.
+// projectID can be a project id or a project key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project/{projectKeyOrId}/notificationscheme-getNotificationScheme
+func (s *ProjectService) GetNotificationScheme(projectID string) (*ProjectScheme, *Response, error) {
+	return s.getScheme(fmt.Sprintf("rest/api/2/project/%s/notificationscheme", projectID))
+}
+
+// GetWorkflowScheme returns the workflow scheme associated with the project with the given projectID.
+// The workflow scheme association is only available on JIRA Cloud and requires the numeric project id,
+// so a project key is resolved to its id first.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-workflowscheme-project-get
+func (s *ProjectService) GetWorkflowScheme(projectID string) (*ProjectScheme, *Response, error) {
+	if _, err := strconv.ParseInt(projectID, 10, 64); err != nil {
+		project, resp, err := s.Get(projectID)
+		if err != nil {
+			return nil, resp, err
+		}
+		projectID = project.ID
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/project?projectId=%s", projectID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Values []struct {
+			WorkflowScheme ProjectScheme `json:"workflowScheme"`
+		} `json:"values"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(result.Values) == 0 {
+		return nil, resp, fmt.Errorf("No workflow scheme is associated with project %s", projectID)
+	}
+	return &result.Values[0].WorkflowScheme, resp, nil
+}
+
+// getScheme fetches a single scheme association from apiEndpoint
+func (s *ProjectService) getScheme(apiEndpoint string) (*ProjectScheme, *Response, error) {
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(ProjectScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -31,6 +33,30 @@ func TestProjectService_GetList(t *testing.T) {
 	}
 }
 
+func TestProjectService_GetRecent(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?recent=2")
+		fmt.Fprint(w, `[{"self":"https://issues.apache.org/jira/rest/api/2/project/10000","id":"10000","key":"EX","name":"Example"},
+			{"self":"https://issues.apache.org/jira/rest/api/2/project/10001","id":"10001","key":"ABC","name":"Alphabetical"}]`)
+	})
+
+	projects, _, err := testClient.Project.GetRecent(2)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(*projects) != 2 {
+		t.Fatalf("Expected 2 projects, got %d", len(*projects))
+	}
+	if (*projects)[0].Key != "EX" {
+		t.Errorf("Expected most recent project EX, got %s", (*projects)[0].Key)
+	}
+}
+
 func TestProjectService_Get(t *testing.T) {
 	setup()
 	defer teardown()
@@ -78,3 +104,157 @@ func TestProjectService_Get_NoProject(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_GetIssueTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/ABDERA"
+
+	raw, err := ioutil.ReadFile("./mocks/project.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	issueTypes, _, err := testClient.Project.GetIssueTypes("ABDERA")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 35 {
+		t.Errorf("Expected 35 issue types, got %d", len(issueTypes))
+	}
+	if issueTypes[0].Name != "Bug" {
+		t.Errorf("Expected first issue type Bug, got %s", issueTypes[0].Name)
+	}
+}
+
+func TestProjectService_GetStatuses(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/EX/statuses"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/issueType/3","id":"3","name":"Task","subtask":false,"statuses":[{"self":"http://www.example.com/jira/rest/api/2/status/10000","description":"The issue is currently being worked on.","iconUrl":"http://www.example.com/jira/images/icons/progress.gif","name":"In Progress","id":"10000"},{"self":"http://www.example.com/jira/rest/api/2/status/5","description":"The issue is closed.","iconUrl":"http://www.example.com/jira/images/icons/closed.gif","name":"Closed","id":"5"}]}]`)
+	})
+
+	issueTypes, _, err := testClient.Project.GetStatuses("EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 1 {
+		t.Fatalf("Expected 1 issue type, got %d", len(issueTypes))
+	}
+	if issueTypes[0].Name != "Task" {
+		t.Errorf("Expected issue type Task, got %s", issueTypes[0].Name)
+	}
+	if len(issueTypes[0].Statuses) != 2 || issueTypes[0].Statuses[1].Name != "Closed" {
+		t.Errorf("Expected statuses In Progress and Closed, got %+v", issueTypes[0].Statuses)
+	}
+}
+
+func TestProjectService_GetPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/EX/permissionscheme"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"expand":"permissions,user,group,projectRole,field,all","id":10000,"self":"http://www.example.com/jira/rest/api/2/permissionscheme/10000","name":"Default Permission Scheme","description":"Default permission scheme"}`)
+	})
+
+	scheme, _, err := testClient.Project.GetPermissionScheme("EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme.ID != 10000 || scheme.Name != "Default Permission Scheme" {
+		t.Errorf("Expected scheme 10000 Default Permission Scheme, got %+v", scheme)
+	}
+}
+
+func TestProjectService_GetNotificationScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/EX/notificationscheme"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"self":"http://www.example.com/jira/rest/api/2/notificationscheme/10100","name":"Default Notification Scheme","notificationSchemeEvents":[]}`)
+	})
+
+	scheme, _, err := testClient.Project.GetNotificationScheme("EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme.ID != 10100 || scheme.Name != "Default Notification Scheme" {
+		t.Errorf("Expected scheme 10100 Default Notification Scheme, got %+v", scheme)
+	}
+}
+
+func TestProjectService_GetWorkflowScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"10000","key":"EX","name":"Example"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/workflowscheme/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/workflowscheme/project?projectId=10000")
+		fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":1,"isLast":true,"values":[{"projectIds":["10000"],"workflowScheme":{"id":101010,"name":"Example workflow scheme","description":"The description of the example workflow scheme.","defaultWorkflow":"jira","self":"https://your-domain.atlassian.net/rest/api/2/workflowscheme/101010"}}]}`)
+	})
+
+	scheme, _, err := testClient.Project.GetWorkflowScheme("EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 101010 {
+		t.Errorf("Expected workflow scheme 101010, got %+v", scheme)
+	}
+}
+
+func TestProjectService_SetPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/EX/permissionscheme"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		payload := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload["id"] != float64(10001) {
+			t.Errorf("Expected scheme id 10001, got %v", payload["id"])
+		}
+		fmt.Fprint(w, `{"id":10001,"self":"http://www.example.com/jira/rest/api/2/permissionscheme/10001","name":"Shared Permission Scheme"}`)
+	})
+
+	scheme, _, err := testClient.Project.SetPermissionScheme("EX", 10001)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme.ID != 10001 {
+		t.Errorf("Expected scheme 10001, got %d", scheme.ID)
+	}
+}
+
+func TestProjectService_SetPermissionScheme_Forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/permissionscheme", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, _, err := testClient.Project.SetPermissionScheme("EX", 10001)
+	if err == nil || !strings.Contains(err.Error(), "administrator") {
+		t.Errorf("Expected a permission error, got %v", err)
+	}
+}
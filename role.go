@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"fmt"
+)
+
+const (
+	// RoleActorTypeUser is the type of an Actor which is a user
+	RoleActorTypeUser = "atlassian-user-role-actor"
+	// RoleActorTypeGroup is the type of an Actor which is a group
+	RoleActorTypeGroup = "atlassian-group-role-actor"
+)
+
+// RoleService handles the global project roles for the JIRA instance / API.
+// The actors returned by it are the default actors, which are assigned to the role of new projects.
+// All endpoints require the Administer JIRA global permission.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/role
+type RoleService struct {
+	client *Client
+	apiVersion
+}
+
+// Role represents a project role in JIRA
+type Role struct {
+	Self        string  `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string  `json:"name,omitempty" structs:"name,omitempty"`
+	ID          int     `json:"id,omitempty" structs:"id,omitempty"`
+	Description string  `json:"description,omitempty" structs:"description,omitempty"`
+	Actors      []Actor `json:"actors,omitempty" structs:"actors,omitempty"`
+}
+
+// Actor represents a user or group assigned to a project role.
+// Type is RoleActorTypeUser or RoleActorTypeGroup, Name is the username or group name.
+type Actor struct {
+	ID          int    `json:"id,omitempty" structs:"id,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Type        string `json:"type,omitempty" structs:"type,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	AvatarURL   string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+}
+
+// roleActorsResult is only a small wrapper around the GetDefaultActors method
+// to be able to parse the results
+type roleActorsResult struct {
+	Actors []Actor `json:"actors"`
+}
+
+// GetList returns all project roles of the JIRA instance, including their default actors.
+// On JIRA Cloud the actors are not listed, use GetDefaultActors for each role instead.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/role-getProjectRoles
+func (s *RoleService) GetList() ([]Role, *Response, error) {
+	apiEndpoint := "rest/api/2/role"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := []Role{}
+	resp, err := s.client.Do(req, &roles)
+	if err != nil {
+		return nil, resp, err
+	}
+	return roles, resp, nil
+}
+
+// GetDefaultActors returns the default actors of the project role roleID.
+// Reading and changing the default actors is only available on JIRA Server / Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/role-getProjectRoleActorsForRole
+func (s *RoleService) GetDefaultActors(roleID int) ([]Actor, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/role/%d/actors", roleID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(roleActorsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Actors, resp, nil
+}
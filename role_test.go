@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRoleService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/role")
+		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/role/10360","name":"Developers","id":10360,"description":"A project role that represents developers in a project","actors":[{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers"}]}]`)
+	})
+
+	roles, _, err := testClient.Role.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(roles) != 1 || roles[0].ID != 10360 || len(roles[0].Actors) != 1 {
+		t.Errorf("Unexpected roles %+v", roles)
+	}
+}
+
+func TestRoleService_GetDefaultActors(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/role/10360/actors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/role/10360/actors")
+		fmt.Fprint(w, `{"actors":[{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers"},{"id":10241,"displayName":"Fred F. User","type":"atlassian-user-role-actor","name":"fred"}]}`)
+	})
+
+	actors, _, err := testClient.Role.GetDefaultActors(10360)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(actors) != 2 || actors[0].Type != RoleActorTypeGroup || actors[1].Type != RoleActorTypeUser || actors[1].Name != "fred" {
+		t.Errorf("Unexpected actors %+v", actors)
+	}
+}
@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"fmt"
+)
+
+// ScreenService handles screens for the JIRA instance / API.
+// Reading screens requires JIRA administrator permissions.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens
+type ScreenService struct {
+	client *Client
+	apiVersion
+}
+
+// ScreensList reflects a paginated list of screens
+type ScreensList struct {
+	MaxResults int      `json:"maxResults" structs:"maxResults"`
+	StartAt    int      `json:"startAt" structs:"startAt"`
+	Total      int      `json:"total" structs:"total"`
+	IsLast     bool     `json:"isLast" structs:"isLast"`
+	Values     []Screen `json:"values" structs:"values"`
+}
+
+// Screen represents a JIRA screen
+type Screen struct {
+	ID          int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// ScreenTab represents a single tab of a screen
+type ScreenTab struct {
+	ID   int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// ScreenableField represents a field which is placed on, or can be added to, a screen tab
+type ScreenableField struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// GetList returns a paginated list of all screens.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-getAllScreens
+func (s *ScreenService) GetList(opt *SearchOptions) (*ScreensList, *Response, error) {
+	apiEndpoint := "rest/api/2/screens"
+	url, err := addOptions(apiEndpoint, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	screens := new(ScreensList)
+	resp, err := s.client.Do(req, screens)
+	if err != nil {
+		return nil, resp, err
+	}
+	return screens, resp, nil
+}
+
+// GetTabs returns all tabs of the screen with the given screenID.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-getAllTabs
+func (s *ScreenService) GetTabs(screenID int) ([]ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tabs := []ScreenTab{}
+	resp, err := s.client.Do(req, &tabs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tabs, resp, nil
+}
+
+// GetTabFields returns all fields which are placed on the given tab of a screen.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-getAllFields
+func (s *ScreenService) GetTabFields(screenID, tabID int) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := []ScreenableField{}
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// GetAvailableFields returns all fields which are not yet placed on the screen with the given screenID
+// and can be added to one of its tabs.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-getFieldsToAdd
+func (s *ScreenService) GetAvailableFields(screenID int) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/availableFields", screenID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := []ScreenableField{}
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
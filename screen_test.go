@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestScreenService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/screens"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?maxResults=2&startAt=1")
+		fmt.Fprint(w, `{"maxResults":2,"startAt":1,"total":5,"isLast":false,"values":[{"id":1,"name":"Default Screen","description":"Allows to update all system fields."},{"id":2,"name":"Workflow Screen","description":"This screen is used in the workflow and enables you to assign issues"}]}`)
+	})
+
+	screens, _, err := testClient.Screen.GetList(&SearchOptions{StartAt: 1, MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if screens.Total != 5 {
+		t.Errorf("Expected 5 screens in total, got %d", screens.Total)
+	}
+	if len(screens.Values) != 2 {
+		t.Errorf("Expected 2 screens, got %d", len(screens.Values))
+	}
+}
+
+func TestScreenService_GetTabs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/screens/1/tabs"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"id":10000,"name":"Field Tab"},{"id":10001,"name":"Details"}]`)
+	})
+
+	tabs, _, err := testClient.Screen.GetTabs(1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(tabs) != 2 {
+		t.Errorf("Expected 2 tabs, got %d", len(tabs))
+	}
+}
+
+func TestScreenService_GetTabFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/screens/1/tabs/10000/fields"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary","type":"System field"},{"id":"customfield_10000","name":"Story Points","type":"Number Field"}]`)
+	})
+
+	fields, _, err := testClient.Screen.GetTabFields(1, 10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if fields[1].ID != "customfield_10000" {
+		t.Errorf("Expected field customfield_10000, got %s", fields[1].ID)
+	}
+}
+
+func TestScreenService_GetAvailableFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/screens/1/availableFields"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"id":"environment","name":"Environment"}]`)
+	})
+
+	fields, _, err := testClient.Screen.GetAvailableFields(1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 1 {
+		t.Errorf("Expected 1 field, got %d", len(fields))
+	}
+}
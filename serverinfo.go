@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"sync"
+)
+
+const (
+	// DeploymentTypeCloud is the deployment type of JIRA Cloud instances
+	DeploymentTypeCloud = "Cloud"
+	// DeploymentTypeServer is the deployment type of JIRA Server and Data Center instances
+	DeploymentTypeServer = "Server"
+)
+
+// ServerInfoService handles the general information of the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/serverInfo
+type ServerInfoService struct {
+	client *Client
+	apiVersion
+
+	mu   sync.Mutex
+	info *ServerInfo
+}
+
+// ServerInfo represents the general information of a JIRA instance
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl,omitempty" structs:"baseUrl,omitempty"`
+	Version        string `json:"version,omitempty" structs:"version,omitempty"`
+	VersionNumbers []int  `json:"versionNumbers,omitempty" structs:"versionNumbers,omitempty"`
+	DeploymentType string `json:"deploymentType,omitempty" structs:"deploymentType,omitempty"`
+	BuildNumber    int    `json:"buildNumber,omitempty" structs:"buildNumber,omitempty"`
+	BuildDate      string `json:"buildDate,omitempty" structs:"buildDate,omitempty"`
+	ServerTime     string `json:"serverTime,omitempty" structs:"serverTime,omitempty"`
+	ScmInfo        string `json:"scmInfo,omitempty" structs:"scmInfo,omitempty"`
+	ServerTitle    string `json:"serverTitle,omitempty" structs:"serverTitle,omitempty"`
+}
+
+// IsCloud reports if the information belongs to a JIRA Cloud instance.
+// Older JIRA Server versions don't report a deployment type at all.
+func (i *ServerInfo) IsCloud() bool {
+	return i.DeploymentType == DeploymentTypeCloud
+}
+
+// Get returns the general information of the JIRA instance.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/serverInfo-getServerInfo
+func (s *ServerInfoService) Get() (*ServerInfo, *Response, error) {
+	apiEndpoint := "rest/api/2/serverInfo"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := new(ServerInfo)
+	resp, err := s.client.Do(req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	s.mu.Lock()
+	s.info = info
+	s.mu.Unlock()
+	return info, resp, nil
+}
+
+// IsCloud reports if the client talks to a JIRA Cloud instance.
+// The deployment type is requested once via Get and cached afterwards.
+func (s *ServerInfoService) IsCloud() (bool, error) {
+	s.mu.Lock()
+	info := s.info
+	s.mu.Unlock()
+
+	if info == nil {
+		var err error
+		info, _, err = s.Get()
+		if err != nil {
+			return false, err
+		}
+	}
+	return info.IsCloud(), nil
+}
@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testServerInfo registers a serverInfo handler reporting the given deployment type
+func testServerInfo(t *testing.T, deploymentType string) {
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"baseUrl":"http://www.example.com/jira","version":"7.4.0","versionNumbers":[7,4,0],"deploymentType":"%s","buildNumber":74002,"serverTitle":"JIRA"}`, deploymentType)
+	})
+}
+
+func TestServerInfoService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
+
+	info, _, err := testClient.ServerInfo.Get()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if info.Version != "7.4.0" {
+		t.Errorf("Expected version 7.4.0, got %s", info.Version)
+	}
+	if info.IsCloud() {
+		t.Error("Expected a server instance")
+	}
+}
+
+func TestServerInfoService_IsCloud(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"baseUrl":"https://example.atlassian.net","version":"1001.0.0-SNAPSHOT","deploymentType":"Cloud"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		cloud, err := testClient.ServerInfo.IsCloud()
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if !cloud {
+			t.Error("Expected a cloud instance")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the server info to be cached, got %d calls", calls)
+	}
+}
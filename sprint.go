@@ -17,7 +17,10 @@ type IssuesWrapper struct {
 
 // IssuesInSprintResult represents a wrapper struct for search result
 type IssuesInSprintResult struct {
-	Issues []Issue `json:"issues"`
+	Issues     []Issue `json:"issues"`
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
 }
 
 // MoveIssuesToSprint moves issues to a sprint, for a given sprint Id.
@@ -58,3 +61,72 @@ func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, er
 	resp, err := s.client.Do(req, result)
 	return result.Issues, resp, err
 }
+
+// CompleteSprintOptions specifies the optional parameters of SprintService.Complete
+type CompleteSprintOptions struct {
+	// MoveIncompleteTo is the ID of the sprint the incomplete issues are moved to after completing the sprint.
+	// If 0, JIRA moves them to the backlog.
+	MoveIncompleteTo int
+}
+
+// Complete closes the active sprint sprintID and returns the issues which were incomplete at that time,
+// i.e. whose status doesn't belong to the status category "done".
+// JIRA moves incomplete issues to the backlog, use options.MoveIncompleteTo to move them to another sprint instead.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) Complete(sprintID int, options *CompleteSprintOptions) ([]Issue, *Response, error) {
+	var incomplete []Issue
+	for startAt := 0; ; {
+		apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue?fields=status&startAt=%d", sprintID, startAt)
+		req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		result := new(IssuesInSprintResult)
+		resp, err := s.client.Do(req, result)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, issue := range result.Issues {
+			if issue.Fields == nil || issue.Fields.Status == nil || issue.Fields.Status.StatusCategory.Key != "done" {
+				incomplete = append(incomplete, issue)
+			}
+		}
+		startAt += len(result.Issues)
+		if len(result.Issues) == 0 || startAt >= result.Total {
+			break
+		}
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequest("POST", apiEndpoint, map[string]string{"state": SprintStateClosed})
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if options == nil || options.MoveIncompleteTo == 0 {
+		return incomplete, resp, nil
+	}
+
+	// At most 50 issues can be moved in one request
+	keys := make([]string, len(incomplete))
+	for i, issue := range incomplete {
+		keys[i] = issue.Key
+	}
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > 50 {
+			n = 50
+		}
+		resp, err = s.MoveIssuesToSprint(options.MoveIncompleteTo, keys[:n])
+		keys = keys[n:]
+		if err != nil {
+			return incomplete, resp, err
+		}
+	}
+	return incomplete, resp, nil
+}
@@ -65,3 +65,57 @@ func TestSprintService_GetIssuesForSprint(t *testing.T) {
 	}
 
 }
+
+func TestSprintService_Complete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/123/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[
+				{"id":"1","key":"EX-1","fields":{"status":{"name":"Done","statusCategory":{"key":"done"}}}},
+				{"id":"2","key":"EX-2","fields":{"status":{"name":"In Progress","statusCategory":{"key":"indeterminate"}}}}
+			]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[
+				{"id":"3","key":"EX-3","fields":{"status":{"name":"To Do","statusCategory":{"key":"new"}}}}
+			]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	closed := false
+	testMux.HandleFunc("/rest/agile/1.0/sprint/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		payload := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload["state"] != "closed" {
+			t.Errorf("Expected state closed, got %v", payload)
+		}
+		closed = true
+		fmt.Fprint(w, `{"id":123,"state":"closed"}`)
+	})
+	var moved []string
+	testMux.HandleFunc("/rest/agile/1.0/sprint/124/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if !closed {
+			t.Error("Expected the sprint to be closed before moving issues")
+		}
+		payload := new(IssuesWrapper)
+		json.NewDecoder(r.Body).Decode(payload)
+		moved = append(moved, payload.Issues...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	incomplete, _, err := testClient.Sprint.Complete(123, &CompleteSprintOptions{MoveIncompleteTo: 124})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(incomplete) != 2 || incomplete[0].Key != "EX-2" || incomplete[1].Key != "EX-3" {
+		t.Errorf("Expected EX-2 and EX-3 to be incomplete, got %+v", incomplete)
+	}
+	if len(moved) != 2 || moved[0] != "EX-2" || moved[1] != "EX-3" {
+		t.Errorf("Expected EX-2 and EX-3 to be moved, got %v", moved)
+	}
+}
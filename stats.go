@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// ClientStats is a snapshot of the request counters of a Client.
+// It helps to tune the concurrency of bulk jobs and to understand throttling by JIRA.
+type ClientStats struct {
+	// Requests is the number of requests sent, including retries
+	Requests int64
+	// Retries is the number of requests retried after being rate limited
+	Retries int64
+	// RateLimited is the number of responses with status 429 Too Many Requests
+	RateLimited int64
+	// Backoff is the total time spent waiting before retries
+	Backoff time.Duration
+}
+
+// clientStats holds the request counters of a Client.
+// All fields are updated atomically.
+type clientStats struct {
+	requests    int64
+	retries     int64
+	rateLimited int64
+	backoff     int64
+}
+
+// Stats returns a snapshot of the request counters of the client.
+func (c *Client) Stats() ClientStats {
+	return ClientStats{
+		Requests:    atomic.LoadInt64(&c.stats.requests),
+		Retries:     atomic.LoadInt64(&c.stats.retries),
+		RateLimited: atomic.LoadInt64(&c.stats.rateLimited),
+		Backoff:     time.Duration(atomic.LoadInt64(&c.stats.backoff)),
+	}
+}
+
+// ResetStats sets all request counters of the client back to zero, e.g. between two bulk runs.
+func (c *Client) ResetStats() {
+	atomic.StoreInt64(&c.stats.requests, 0)
+	atomic.StoreInt64(&c.stats.retries, 0)
+	atomic.StoreInt64(&c.stats.rateLimited, 0)
+	atomic.StoreInt64(&c.stats.backoff, 0)
+}
+
+// countResponse records a request and its response in the counters
+func (s *clientStats) countResponse(resp *http.Response) {
+	atomic.AddInt64(&s.requests, 1)
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&s.rateLimited, 1)
+	}
+}
+
+// countRetry records a retry after waiting for the given duration in the counters
+func (s *clientStats) countRetry(wait time.Duration) {
+	atomic.AddInt64(&s.retries, 1)
+	atomic.AddInt64(&s.backoff, int64(wait))
+}
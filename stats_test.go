@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Stats(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"version":"7.4.0"}`))
+	})
+
+	testClient.doWithBackoff(func() (*Response, error) {
+		_, resp, err := testClient.ServerInfo.Get()
+		return resp, err
+	})
+
+	stats := testClient.Stats()
+	if stats.Requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", stats.Requests)
+	}
+	if stats.Retries != 1 {
+		t.Errorf("Expected 1 retry, got %d", stats.Retries)
+	}
+	if stats.RateLimited != 1 {
+		t.Errorf("Expected 1 rate limited response, got %d", stats.RateLimited)
+	}
+	if stats.Backoff != time.Millisecond {
+		t.Errorf("Expected a backoff of 1ms, got %s", stats.Backoff)
+	}
+
+	testClient.ResetStats()
+	if stats := testClient.Stats(); stats != (ClientStats{}) {
+		t.Errorf("Expected reset stats, got %+v", stats)
+	}
+}
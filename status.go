@@ -0,0 +1,37 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StatusService handles issue statuses for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/status
+type StatusService struct {
+	client *Client
+	apiVersion
+}
+
+// Get returns the status with the given ID or name, including its status category.
+// If no such status exists (or is visible to the current user), a descriptive error is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/status-getStatus
+func (s *StatusService) Get(idOrName string) (*Status, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/status/%s", url.PathEscape(idOrName))
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(Status)
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, resp, fmt.Errorf("Status %q does not exist. Status code: %d", idOrName, resp.StatusCode)
+		}
+		return nil, resp, err
+	}
+	return status, resp, nil
+}
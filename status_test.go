@@ -0,0 +1,39 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestStatusService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/status/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/status/In%20Progress")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/status/3","description":"This issue is being actively worked on at the moment by the assignee.","iconUrl":"http://www.example.com/jira/images/icons/statuses/inprogress.png","name":"In Progress","id":"3","statusCategory":{"self":"http://www.example.com/jira/rest/api/2/statuscategory/4","id":4,"key":"indeterminate","colorName":"yellow","name":"In Progress"}}`)
+	})
+
+	status, _, err := testClient.Status.Get("In Progress")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if status.ID != "3" || status.StatusCategory.ColorName != "yellow" {
+		t.Errorf("Unexpected status %+v", status)
+	}
+}
+
+func TestStatusService_Get_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/status/10042", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := testClient.Status.Get("10042")
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Expected a descriptive error, got %v", err)
+	}
+}
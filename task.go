@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"fmt"
+)
+
+const (
+	// TaskStatusEnqueued represents a task which has not been started yet
+	TaskStatusEnqueued = "ENQUEUED"
+	// TaskStatusRunning represents a task which is currently executed
+	TaskStatusRunning = "RUNNING"
+	// TaskStatusComplete represents a task which has finished successfully
+	TaskStatusComplete = "COMPLETE"
+	// TaskStatusFailed represents a task which has finished with an error
+	TaskStatusFailed = "FAILED"
+	// TaskStatusCancelled represents a task which has been cancelled
+	TaskStatusCancelled = "CANCELLED"
+)
+
+// TaskService handles asynchronous tasks for the JIRA instance / API.
+// Long running operations (e.g. bulk changes) return a task which can be polled for its progress.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-group-Tasks
+type TaskService struct {
+	client *Client
+	apiVersion
+}
+
+// Task represents an asynchronous task in JIRA
+type Task struct {
+	Self           string      `json:"self,omitempty" structs:"self,omitempty"`
+	ID             string      `json:"id,omitempty" structs:"id,omitempty"`
+	Description    string      `json:"description,omitempty" structs:"description,omitempty"`
+	Status         string      `json:"status,omitempty" structs:"status,omitempty"`
+	Message        string      `json:"message,omitempty" structs:"message,omitempty"`
+	Result         interface{} `json:"result,omitempty" structs:"result,omitempty"`
+	SubmittedBy    int64       `json:"submittedBy,omitempty" structs:"submittedBy,omitempty"`
+	Progress       int         `json:"progress" structs:"progress"`
+	ElapsedRuntime int64       `json:"elapsedRuntime,omitempty" structs:"elapsedRuntime,omitempty"`
+	Submitted      int64       `json:"submitted,omitempty" structs:"submitted,omitempty"`
+	Started        int64       `json:"started,omitempty" structs:"started,omitempty"`
+	Finished       int64       `json:"finished,omitempty" structs:"finished,omitempty"`
+	LastUpdate     int64       `json:"lastUpdate,omitempty" structs:"lastUpdate,omitempty"`
+}
+
+// Done reports if the task has finished, either successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Get returns the status of the task with the given taskID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-task-taskId-get
+func (s *TaskService) Get(taskID string) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/task/%s", taskID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, err
+	}
+	return task, resp, nil
+}
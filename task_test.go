@@ -0,0 +1,30 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTaskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/task/10641"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/10641","id":"10641","description":"Task description","status":"COMPLETE","result":"the task result, this may be any JSON","submittedBy":10000,"progress":100,"elapsedRuntime":156,"submitted":1501708132800,"started":1501708132900,"finished":1501708133000,"lastUpdate":1501708133000}`)
+	})
+
+	task, _, err := testClient.Task.Get("10641")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if task.Progress != 100 {
+		t.Errorf("Expected progress 100, got %d", task.Progress)
+	}
+	if !task.Done() {
+		t.Error("Expected task to be done")
+	}
+}
@@ -3,8 +3,12 @@ package jira
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/url"
+	"sync"
+
+	"github.com/google/go-querystring/query"
 )
 
 // UserService handles users for the JIRA instance / API.
@@ -12,6 +16,10 @@ import (
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user
 type UserService struct {
 	client *Client
+	apiVersion
+
+	mu   sync.Mutex
+	self *User
 }
 
 // User represents a JIRA user.
@@ -26,6 +34,34 @@ type User struct {
 	Active          bool       `json:"active,omitempty" structs:"active,omitempty"`
 	TimeZone        string     `json:"timeZone,omitempty" structs:"timeZone,omitempty"`
 	ApplicationKeys []string   `json:"applicationKeys,omitempty" structs:"applicationKeys,omitempty"`
+	// Groups is only populated if the user was requested with the groups expanded, e.g. by GetSelf
+	Groups *UserGroups `json:"groups,omitempty" structs:"groups,omitempty"`
+}
+
+// UserGroups represents the groups a JIRA user is a member of
+type UserGroups struct {
+	Size  int         `json:"size" structs:"size"`
+	Items []UserGroup `json:"items" structs:"items"`
+}
+
+// UserGroup represents a single group in UserGroups
+type UserGroup struct {
+	Name string `json:"name" structs:"name"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+}
+
+// IsMemberOf reports if the user is a member of the group with the given name.
+// It is only meaningful if the groups of the user were requested, see User.Groups.
+func (u *User) IsMemberOf(group string) bool {
+	if u.Groups == nil {
+		return false
+	}
+	for _, g := range u.Groups.Items {
+		if g.Name == group {
+			return true
+		}
+	}
+	return false
 }
 
 // Get gets user info from JIRA
@@ -33,7 +69,7 @@ type User struct {
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUser
 func (s *UserService) Get(username string) (*User, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", username)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -46,12 +82,58 @@ func (s *UserService) Get(username string) (*User, *Response, error) {
 	return user, resp, nil
 }
 
+// CreateUserInput is the payload to create a user in JIRA.
+// Unlike User it includes the password, which is never returned by JIRA.
+type CreateUserInput struct {
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	Key          string `json:"key,omitempty" structs:"key,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	DisplayName  string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	// Password is the initial password of the user (JIRA Server only).
+	// If empty, JIRA generates a random password and the user has to reset it.
+	Password string `json:"password,omitempty" structs:"password,omitempty"`
+	// Notification sends an email to the new user, asking to set a password.
+	// On JIRA Cloud users are always invited via email.
+	Notification    bool     `json:"notification,omitempty" structs:"notification,omitempty"`
+	ApplicationKeys []string `json:"applicationKeys,omitempty" structs:"applicationKeys,omitempty"`
+}
+
 // Create creates an user in JIRA.
+// The password of user is sent along, see CreateUserInput.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-createUser
 func (s *UserService) Create(user *User) (*User, *Response, error) {
+	input := &CreateUserInput{
+		Name:            user.Name,
+		Key:             user.Key,
+		EmailAddress:    user.EmailAddress,
+		DisplayName:     user.DisplayName,
+		Password:        user.Password,
+		ApplicationKeys: user.ApplicationKeys,
+	}
+	return s.CreateWithInput(input)
+}
+
+// CreateWithInput creates an user in JIRA from the given input.
+// The behaviour depends on the deployment type of JIRA:
+// On JIRA Server the initial password is set and an email is only sent if Notification is true.
+// On JIRA Cloud passwords can't be set, so the password is dropped and the user is invited via email.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-createUser
+func (s *UserService) CreateWithInput(input *CreateUserInput) (*User, *Response, error) {
+	cloud, err := s.client.ServerInfo.IsCloud()
+	if err != nil {
+		return nil, nil, err
+	}
+	if cloud {
+		invitation := *input
+		invitation.Password = ""
+		invitation.Notification = true
+		input = &invitation
+	}
+
 	apiEndpoint := "/rest/api/2/user"
-	req, err := s.client.NewRequest("POST", apiEndpoint, user)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), input)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,6 +156,132 @@ func (s *UserService) Create(user *User) (*User, *Response, error) {
 	return responseUser, resp, nil
 }
 
+// SetActive activates or deactivates the user with the given username and returns the updated user.
+// Deactivating a user keeps the history of the user, in contrast to deleting it.
+// This is only supported by JIRA Server. On JIRA Cloud users are managed via the
+// Atlassian organization admin API, so an error is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-updateUser
+func (s *UserService) SetActive(username string, active bool) (*User, *Response, error) {
+	cloud, err := s.client.ServerInfo.IsCloud()
+	if err != nil {
+		return nil, nil, err
+	}
+	if cloud {
+		return nil, nil, fmt.Errorf("Activating or deactivating users is not supported on JIRA Cloud, use the Atlassian organization admin API instead")
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/user?username=%s", url.QueryEscape(username))
+	payload := struct {
+		Active bool `json:"active"`
+	}{active}
+	req, err := s.client.NewRequest("PUT", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+	return user, resp, nil
+}
+
+// GetAvatarImage downloads the avatar image of user in the given size ("16x16", "24x24", "32x32" or "48x48").
+// The request is authenticated like every other request of the client, so private avatars can be read.
+// It returns the image and its content type. The caller has to close the image.
+// To avoid leaking credentials, only avatars hosted by the JIRA instance itself are downloaded,
+// with the same scheme as the base URL of the client.
+func (s *UserService) GetAvatarImage(user *User, size string) (io.ReadCloser, string, error) {
+	var avatarURL string
+	switch size {
+	case "16x16":
+		avatarURL = user.AvatarUrls.One6X16
+	case "24x24":
+		avatarURL = user.AvatarUrls.Two4X24
+	case "32x32":
+		avatarURL = user.AvatarUrls.Three2X32
+	case "48x48":
+		avatarURL = user.AvatarUrls.Four8X48
+	default:
+		return nil, "", fmt.Errorf("Unknown avatar size: %s", size)
+	}
+	if avatarURL == "" {
+		return nil, "", fmt.Errorf("User %s has no avatar of size %s", user.Name, size)
+	}
+
+	req, err := s.client.NewRequest("GET", avatarURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if req.URL.Host != s.client.baseURL.Host || req.URL.Scheme != s.client.baseURL.Scheme {
+		return nil, "", fmt.Errorf("Avatar %s is not hosted by JIRA, download it without authentication", avatarURL)
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// UserPickerOptions specifies the optional parameters of UserService.Picker
+type UserPickerOptions struct {
+	// MaxResults: The maximum number of users to return. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// ShowAvatar: If true, the avatar URL of each user is returned.
+	ShowAvatar bool `url:"showAvatar,omitempty"`
+	// Exclude: Names of users to leave out of the result, e.g. users which are already selected.
+	Exclude []string `url:"exclude,omitempty"`
+}
+
+// UserPickerResult is the result of UserService.Picker
+type UserPickerResult struct {
+	Header string           `json:"header,omitempty" structs:"header,omitempty"`
+	Total  int              `json:"total" structs:"total"`
+	Users  []UserSuggestion `json:"users" structs:"users"`
+}
+
+// UserSuggestion represents a single user suggested by UserService.Picker
+type UserSuggestion struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	// HTML is the display name and name of the user with the matching part highlighted
+	HTML string `json:"html,omitempty" structs:"html,omitempty"`
+	// AvatarURL is only returned if UserPickerOptions.ShowAvatar is true
+	AvatarURL string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+}
+
+// Picker returns the users matching term, e.g. for a user picker or mentions.
+// This is the lightweight endpoint used by the pickers of JIRA itself and
+// considerably faster than FindUsers for autocompletion.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/user-findUsersForPicker
+func (s *UserService) Picker(term string, options *UserPickerOptions) (*UserPickerResult, *Response, error) {
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, "rest/api/2/user/picker"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := url.Values{}
+	if options != nil {
+		if q, err = query.Values(options); err != nil {
+			return nil, nil, err
+		}
+	}
+	q.Set("query", term)
+	req.URL.RawQuery = q.Encode()
+
+	result := new(UserPickerResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
 // SearchOptions specifies the optional parameters to various List methods that
 // support pagination.
 // Pagination is used for the JIRA REST APIs to conserve server resources and limit
@@ -103,17 +311,23 @@ func (s *UserService) FindUsers(username string, options *FindUsersOptions) ([]U
 	var u string
 	if options == nil {
 		u = fmt.Sprintf("rest/api/2/user/search?username=%s", username)
+		if s.client.DefaultPageSize > 0 {
+			u += fmt.Sprintf("&maxResults=%d", s.client.DefaultPageSize)
+		}
 	} else {
 		u = fmt.Sprintf(
-			"rest/api/2/user/search?username=%s&startAt=%d&maxResults=%d"+
+			"rest/api/2/user/search?username=%s&startAt=%d"+
 				"&includeActive=%t&includeInactive=%t&Property=%s",
-			url.QueryEscape(username), options.StartAt, options.MaxResults,
+			url.QueryEscape(username), options.StartAt,
 			options.IncludeActive, options.IncludeInactive,
 			url.QueryEscape(options.Property))
+		if pageSize := s.client.pageSize(options.MaxResults); pageSize > 0 {
+			u += fmt.Sprintf("&maxResults=%d", pageSize)
+		}
 	}
 
 	users := []User{}
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, u), nil)
 	if err != nil {
 		return []User{}, nil, err
 	}
@@ -121,3 +335,73 @@ func (s *UserService) FindUsers(username string, options *FindUsersOptions) ([]U
 	resp, err := s.client.Do(req, &users)
 	return users, resp, err
 }
+
+// GetRecentActivity returns up to limit issues the user accountOrName is assignee or reporter of,
+// the most recently updated first. The issues only contain the fields summary, status and updated.
+// On JIRA Cloud accountOrName is the account id, the username otherwise. If empty, the authenticated user is used.
+// A limit below 1 returns a page of Client.DefaultPageSize issues, or of the default page size of JIRA if that isn't set.
+// The activity stream (/activity) is an Atom feed and not used here.
+func (s *UserService) GetRecentActivity(accountOrName string, limit int) ([]Issue, *Response, error) {
+	user := "currentUser()"
+	if accountOrName != "" {
+		user = quoteJQL(accountOrName)
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	jql := fmt.Sprintf("assignee = %s OR reporter = %s", user, user)
+	return s.client.Issue.Search(jql, &SearchOptions{
+		MaxResults: limit,
+		Fields:     []string{"summary", "status", "updated"},
+		OrderBy:    []OrderClause{{Field: "updated"}},
+	})
+}
+
+// GetSelf returns the authenticated user including their groups.
+// The user is cached after the first call, further calls return the cached user
+// and a nil Response, unless Client.DisableUserCache is set.
+// The cache is cleared by AuthenticationService.AcquireSessionCookie and AuthenticationService.Logout.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/myself-getUser
+func (s *UserService) GetSelf() (*User, *Response, error) {
+	if !s.client.DisableUserCache {
+		s.mu.Lock()
+		self := s.self
+		s.mu.Unlock()
+		if self != nil {
+			return self, nil, nil
+		}
+	}
+	return s.RefreshSelf()
+}
+
+// RefreshSelf requests the authenticated user including their groups and replaces the cached user of GetSelf.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/myself-getUser
+func (s *UserService) RefreshSelf() (*User, *Response, error) {
+	apiEndpoint := "rest/api/2/myself?expand=groups"
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if !s.client.DisableUserCache {
+		s.mu.Lock()
+		s.self = user
+		s.mu.Unlock()
+	}
+	return user, resp, nil
+}
+
+// clearSelf clears the cached user of GetSelf, e.g. because the authenticated user changed
+func (s *UserService) clearSelf() {
+	s.mu.Lock()
+	s.self = nil
+	s.mu.Unlock()
+}
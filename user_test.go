@@ -1,7 +1,9 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -32,10 +34,17 @@ func TestUserService_Get_Success(t *testing.T) {
 func TestUserService_Create(t *testing.T) {
 	setup()
 	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
 	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
 		testRequestURL(t, r, "/rest/api/2/user")
 
+		input := new(CreateUserInput)
+		json.NewDecoder(r.Body).Decode(input)
+		if input.Password != "abracadabra" {
+			t.Errorf("Expected the password to be sent, got %q", input.Password)
+		}
+
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"name":"charlie","password":"abracadabra","emailAddress":"charlie@atlassian.com",
         "displayName":"Charlie of Atlassian","applicationKeys":["jira-core"]}`)
@@ -55,3 +64,258 @@ func TestUserService_Create(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_CreateWithInput_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeCloud)
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		payload := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if _, ok := payload["password"]; ok {
+			t.Error("Expected no password to be sent")
+		}
+		if payload["notification"] != true {
+			t.Errorf("Expected an invitation to be sent, got %v", payload["notification"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"charlie","emailAddress":"charlie@atlassian.com","displayName":"Charlie of Atlassian"}`)
+	})
+
+	input := &CreateUserInput{
+		Name:         "charlie",
+		EmailAddress: "charlie@atlassian.com",
+		DisplayName:  "Charlie of Atlassian",
+		Password:     "abracadabra",
+	}
+	user, _, err := testClient.User.CreateWithInput(input)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if user == nil || user.Name != "charlie" {
+		t.Errorf("Expected user charlie, got %+v", user)
+	}
+	if input.Password != "abracadabra" {
+		t.Error("Expected the input not to be modified")
+	}
+}
+
+func TestUserService_SetActive(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeServer)
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/user?username=fred")
+
+		payload := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload["active"] != false {
+			t.Errorf("Expected active to be false, got %v", payload["active"])
+		}
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}`)
+	})
+
+	user, _, err := testClient.User.SetActive("fred", false)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if user == nil || user.Active {
+		t.Errorf("Expected an inactive user, got %+v", user)
+	}
+}
+
+func TestUserService_SetActive_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testServerInfo(t, DeploymentTypeCloud)
+
+	_, _, err := testClient.User.SetActive("fred", false)
+	if err == nil {
+		t.Error("Expected an error on JIRA Cloud")
+	}
+}
+
+func TestUserService_GetAvatarImage(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/secure/useravatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/secure/useravatar?size=large&ownerId=fred")
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "PNG")
+	})
+
+	user := &User{Name: "fred", AvatarUrls: AvatarUrls{Four8X48: testServer.URL + "/secure/useravatar?size=large&ownerId=fred"}}
+	image, contentType, err := testClient.User.GetAvatarImage(user, "48x48")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer image.Close()
+
+	if contentType != "image/png" {
+		t.Errorf("Expected content type image/png, got %s", contentType)
+	}
+	data, _ := ioutil.ReadAll(image)
+	if string(data) != "PNG" {
+		t.Errorf("Expected image data PNG, got %s", data)
+	}
+}
+
+func TestUserService_GetAvatarImage_ForeignHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	user := &User{Name: "fred", AvatarUrls: AvatarUrls{Four8X48: "https://avatar.example.org/fred.png"}}
+	if _, _, err := testClient.User.GetAvatarImage(user, "48x48"); err == nil {
+		t.Error("Expected an error for an avatar hosted outside of JIRA")
+	}
+}
+
+func TestUserService_GetAvatarImage_InsecureScheme(t *testing.T) {
+	c, _ := NewClient(nil, "https://jira.example.org/")
+
+	user := &User{Name: "fred", AvatarUrls: AvatarUrls{Four8X48: "http://jira.example.org/secure/useravatar?ownerId=fred"}}
+	if _, _, err := c.User.GetAvatarImage(user, "48x48"); err == nil {
+		t.Error("Expected an error for an avatar downloaded without TLS")
+	}
+}
+
+func TestUserService_Picker(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/picker?exclude=charlie&maxResults=5&query=fr&showAvatar=true")
+		fmt.Fprint(w, `{"users":[{"name":"fred","key":"fred","html":"<strong>Fr</strong>ed F. User - fred@example.com (<strong>fr</strong>ed)","displayName":"Fred F. User","avatarUrl":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred"}],"total":1,"header":"Showing 1 of 1 matching users"}`)
+	})
+
+	result, _, err := testClient.User.Picker("fr", &UserPickerOptions{MaxResults: 5, ShowAvatar: true, Exclude: []string{"charlie"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result.Total != 1 || len(result.Users) != 1 {
+		t.Fatalf("Expected 1 user, got %+v", result)
+	}
+	if result.Users[0].DisplayName != "Fred F. User" || result.Users[0].AvatarURL == "" {
+		t.Errorf("Expected Fred F. User with avatar, got %+v", result.Users[0])
+	}
+}
+
+func TestUserService_FindUsers_DefaultPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.DefaultPageSize = 25
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/search?username=fred&maxResults=25")
+		fmt.Fprint(w, `[{"name":"fred","displayName":"Fred F. User"}]`)
+	})
+
+	users, _, err := testClient.User.FindUsers("fred", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("Expected 1 user, got %d", len(users))
+	}
+}
+
+func TestUserService_FindUsers_NoPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/search?username=fred&startAt=0&includeActive=true&includeInactive=false&Property=")
+		fmt.Fprint(w, `[{"name":"fred","displayName":"Fred F. User"}]`)
+	})
+
+	users, _, err := testClient.User.FindUsers("fred", &FindUsersOptions{IncludeActive: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("Expected 1 user, got %d", len(users))
+	}
+}
+
+func TestUserService_GetRecentActivity(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		query := r.URL.Query()
+		if jql := query.Get("jql"); jql != `assignee = "fred" OR reporter = "fred" ORDER BY updated DESC` {
+			t.Errorf("Unexpected JQL %s", jql)
+		}
+		if query.Get("maxResults") != "2" || query.Get("fields") != "summary,status,updated" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":5,"issues":[{"key":"EX-2","fields":{"summary":"Second","updated":"2017-06-02T10:00:00.000+0000"}},{"key":"EX-1","fields":{"summary":"First","updated":"2017-06-01T10:00:00.000+0000"}}]}`)
+	})
+
+	issues, _, err := testClient.User.GetRecentActivity("fred", 2)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "EX-2" || issues[0].Fields.Updated != "2017-06-02T10:00:00.000+0000" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+}
+
+func TestUserService_GetRecentActivity_NoLimit(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.DefaultPageSize = 25
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if maxResults := r.URL.Query().Get("maxResults"); maxResults != "25" {
+			t.Errorf("Expected the default page size 25, got %q", maxResults)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":25,"total":1,"issues":[{"key":"EX-1","fields":{"updated":"2017-06-01T10:00:00.000+0000"}}]}`)
+	})
+
+	issues, _, err := testClient.User.GetRecentActivity("", 0)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues))
+	}
+}
+
+func TestUserService_GetSelf(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/myself?expand=groups")
+		fmt.Fprint(w, `{"name":"fred","displayName":"Fred F. User","groups":{"size":2,"items":[{"name":"jira-administrators"},{"name":"jira-users"}]}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		user, _, err := testClient.User.GetSelf()
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if user.Name != "fred" || !user.IsMemberOf("jira-administrators") || user.IsMemberOf("jira-developers") {
+			t.Errorf("Unexpected user %+v", user)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+
+	testClient.User.RefreshSelf()
+	testClient.DisableUserCache = true
+	testClient.User.GetSelf()
+	if calls != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls)
+	}
+}
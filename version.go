@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// VersionService handles Versions for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version
+type VersionService struct {
+	client *Client
+	apiVersion
+}
+
+// VersionMovePosition represents a position a version can be moved to, relative to the other versions of its project
+type VersionMovePosition string
+
+const (
+	// VersionMoveFirst moves the version to the first position
+	VersionMoveFirst VersionMovePosition = "First"
+	// VersionMoveLast moves the version to the last position
+	VersionMoveLast VersionMovePosition = "Last"
+	// VersionMoveEarlier moves the version one position up
+	VersionMoveEarlier VersionMovePosition = "Earlier"
+	// VersionMoveLater moves the version one position down
+	VersionMoveLater VersionMovePosition = "Later"
+)
+
+// versionMovePayload is the request payload of the Move* methods.
+// Only one of After or Position must be set.
+type versionMovePayload struct {
+	After    string              `json:"after,omitempty"`
+	Position VersionMovePosition `json:"position,omitempty"`
+}
+
+// DeleteVersionOptions specifies the optional parameters for the VersionService.Delete method
+type DeleteVersionOptions struct {
+	// MoveFixIssuesTo is the version to set fixVersion to on issues where the deleted version is the fix version
+	MoveFixIssuesTo string `url:"moveFixIssuesTo,omitempty"`
+	// MoveAffectedIssuesTo is the version to set affectedVersion to on issues where the deleted version is the affected version
+	MoveAffectedIssuesTo string `url:"moveAffectedIssuesTo,omitempty"`
+}
+
+// VersionRelatedIssueCounts represents the number of issues which have a given version as fix or affects version
+type VersionRelatedIssueCounts struct {
+	Self                string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesFixedCount    int    `json:"issuesFixedCount" structs:"issuesFixedCount"`
+	IssuesAffectedCount int    `json:"issuesAffectedCount" structs:"issuesAffectedCount"`
+}
+
+// VersionUnresolvedIssueCount represents the number of unresolved issues of a given version
+type VersionUnresolvedIssueCount struct {
+	Self                  string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount" structs:"issuesUnresolvedCount"`
+	IssuesCount           int    `json:"issuesCount,omitempty" structs:"issuesCount,omitempty"`
+}
+
+// Get gets version info from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-getVersion
+func (s *VersionService) Get(versionID string) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s", versionID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+	return version, resp, nil
+}
+
+// GetRelatedIssueCounts returns the number of issues which use the version as fix version and as affects version.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-getVersionRelatedIssues
+func (s *VersionService) GetRelatedIssueCounts(versionID string) (*VersionRelatedIssueCounts, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/relatedIssueCounts", versionID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := new(VersionRelatedIssueCounts)
+	resp, err := s.client.Do(req, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return counts, resp, nil
+}
+
+// GetUnresolvedIssueCount returns the number of unresolved issues for the version.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-getVersionUnresolvedIssues
+func (s *VersionService) GetUnresolvedIssueCount(versionID string) (*VersionUnresolvedIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/unresolvedIssueCount", versionID)
+	req, err := s.client.NewRequest("GET", s.client.apiEndpoint(s.APIVersion, apiEndpoint), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(VersionUnresolvedIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, err
+	}
+	return count, resp, nil
+}
+
+// Move moves a version to the given position within the ordered list of versions of its project.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-moveVersion
+func (s *VersionService) Move(versionID string, position VersionMovePosition) (*Version, *Response, error) {
+	return s.move(versionID, &versionMovePayload{Position: position})
+}
+
+// MoveAfter moves a version so that it is placed directly after the version with the given afterVersionID.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-moveVersion
+func (s *VersionService) MoveAfter(versionID, afterVersionID string) (*Version, *Response, error) {
+	// JIRA expects the "after" version to be referenced by its self URL
+	rel, err := url.Parse(fmt.Sprintf("rest/api/2/version/%s", afterVersionID))
+	if err != nil {
+		return nil, nil, err
+	}
+	after := s.client.baseURL.ResolveReference(rel)
+
+	return s.move(versionID, &versionMovePayload{After: after.String()})
+}
+
+func (s *VersionService) move(versionID string, payload *versionMovePayload) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/move", versionID)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+	return version, resp, nil
+}
+
+// Delete deletes a version.
+// Issues using the version as fix version are moved to the version moveFixTo and
+// issues using it as affects version are moved to the version moveAffectedTo.
+// If one of them is empty, the reference is removed from the issues instead.
+// An error is returned without deleting anything if a given replacement version doesn't exist.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/version-delete
+func (s *VersionService) Delete(versionID string, moveFixTo, moveAffectedTo string) (*Response, error) {
+	for _, replacementID := range []string{moveFixTo, moveAffectedTo} {
+		if replacementID == "" {
+			continue
+		}
+		if _, resp, err := s.Get(replacementID); err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return resp, fmt.Errorf("Replacement version %s could not be found: %s", replacementID, err)
+			}
+			return resp, err
+		}
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s", versionID)
+	opts := &DeleteVersionOptions{
+		MoveFixIssuesTo:      moveFixTo,
+		MoveAffectedIssuesTo: moveAffectedTo,
+	}
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", s.client.apiEndpoint(s.APIVersion, url), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	return resp, err
+}
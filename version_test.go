@@ -0,0 +1,215 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestVersionService_Get_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10002")
+
+		fmt.Fprint(w, `{
+			"self": "http://www.example.com/jira/rest/api/2/version/10002",
+			"id": "10002",
+			"description": "An excellent version",
+			"name": "New Version 1",
+			"archived": false,
+			"released": true,
+			"releaseDate": "2010-07-06",
+			"overdue": true,
+			"userReleaseDate": "6/Jul/2010",
+			"projectId": 10000
+		}`)
+	})
+
+	version, _, err := testClient.Version.Get("10002")
+	if version == nil {
+		t.Error("Expected version. Version is nil")
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version.ProjectID != 10000 {
+		t.Errorf("Expected project id 10000, got %d", version.ProjectID)
+	}
+}
+
+func TestVersionService_ContextPath(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/jira/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"10002","name":"New Version 1","projectId":10000}`)
+	})
+	testMux.HandleFunc("/jira/rest/api/2/version/10002/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"issuesUnresolvedCount":3}`)
+	})
+
+	client, _ := NewClient(nil, testServer.URL+"/jira/")
+	if _, _, err := client.Version.Get("10002"); err != nil {
+		t.Errorf("Expected the version below the context path, got %v", err)
+	}
+	if _, _, err := client.Version.GetUnresolvedIssueCount("10002"); err != nil {
+		t.Errorf("Expected the count below the context path, got %v", err)
+	}
+}
+
+func TestVersionService_GetRelatedIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10002/relatedIssueCounts")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10002","issuesFixedCount":23,"issuesAffectedCount":101}`)
+	})
+
+	counts, _, err := testClient.Version.GetRelatedIssueCounts("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if counts.IssuesFixedCount != 23 {
+		t.Errorf("Expected 23 fixed issues, got %d", counts.IssuesFixedCount)
+	}
+	if counts.IssuesAffectedCount != 101 {
+		t.Errorf("Expected 101 affected issues, got %d", counts.IssuesAffectedCount)
+	}
+}
+
+func TestVersionService_GetUnresolvedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10002/unresolvedIssueCount")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10002","issuesUnresolvedCount":23,"issuesCount":30}`)
+	})
+
+	count, _, err := testClient.Version.GetUnresolvedIssueCount("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if count.IssuesUnresolvedCount != 23 {
+		t.Errorf("Expected 23 unresolved issues, got %d", count.IssuesUnresolvedCount)
+	}
+}
+
+func TestVersionService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/version/10002/move")
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["position"] != "First" {
+			t.Errorf("Expected position First, got %s", payload["position"])
+		}
+		if _, ok := payload["after"]; ok {
+			t.Error("Expected no after in payload")
+		}
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10002","id":"10002","name":"New Version 1","projectId":10000}`)
+	})
+
+	version, _, err := testClient.Version.Move("10002", VersionMoveFirst)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version == nil || version.ID != "10002" {
+		t.Errorf("Expected version 10002, got %+v", version)
+	}
+}
+
+func TestVersionService_MoveAfter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/version/10002/move")
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if want := testServer.URL + "/rest/api/2/version/10001"; payload["after"] != want {
+			t.Errorf("Expected after %s, got %s", want, payload["after"])
+		}
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10002","id":"10002","name":"New Version 1","projectId":10000}`)
+	})
+
+	_, _, err := testClient.Version.MoveAfter("10002", "10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10001","id":"10001","name":"Version 0.9","projectId":10000}`)
+	})
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/version/10002?moveAffectedIssuesTo=10001&moveFixIssuesTo=10001")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := testClient.Version.Delete("10002", "10001", "10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected Status code %d. Given %d", http.StatusNoContent, resp.StatusCode)
+	}
+}
+
+func TestVersionService_Delete_UnknownReplacement(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/99999", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to delete the version")
+	})
+
+	_, err := testClient.Version.Delete("10002", "99999", "")
+	if err == nil || !strings.Contains(err.Error(), "could not be found") {
+		t.Errorf("Expected an error for an unknown replacement version, got %v", err)
+	}
+}
+
+func TestVersionService_Delete_ReplacementCheckFails(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10003", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to delete the version")
+	})
+
+	_, err := testClient.Version.Delete("10002", "10003", "")
+	if err == nil || strings.Contains(err.Error(), "could not be found") {
+		t.Errorf("Expected the original error, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WorklogService handles worklogs for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addWorklog
+type WorklogService struct {
+	client *Client
+	apiVersion
+}
+
+// WorklogImport represents a single worklog which should be imported by WorklogService.ImportBulk.
+// Author is the username the work is logged for. If it is empty, the work is logged for the current user.
+// Setting the author requires the permission to edit all worklogs of the issue.
+type WorklogImport struct {
+	IssueKey   string
+	Started    time.Time
+	TimeSpent  string
+	Comment    string
+	Author     string
+	Visibility *CommentVisibility
+}
+
+// WorklogImportResult represents the outcome of importing a single WorklogImport.
+// Either Worklog or Error is set.
+type WorklogImportResult struct {
+	Entry    WorklogImport
+	Worklog  *WorklogRecord
+	Response *Response
+	Error    error
+}
+
+// worklogPayload represents the request payload to add a worklog
+type worklogPayload struct {
+	Started    string             `json:"started" structs:"started"`
+	TimeSpent  string             `json:"timeSpent" structs:"timeSpent"`
+	Comment    string             `json:"comment,omitempty" structs:"comment,omitempty"`
+	Author     *User              `json:"author,omitempty" structs:"author,omitempty"`
+	Visibility *CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+}
+
+// Import adds a single worklog entry to the issue entry.IssueKey.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addWorklog
+func (s *WorklogService) Import(entry WorklogImport) (*WorklogRecord, *Response, error) {
+	payload := &worklogPayload{
+		Started:    entry.Started.Format("2006-01-02T15:04:05.000-0700"),
+		TimeSpent:  entry.TimeSpent,
+		Comment:    entry.Comment,
+		Visibility: entry.Visibility,
+	}
+	if entry.Author != "" {
+		payload.Author = &User{Name: entry.Author}
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", entry.IssueKey)
+	req, err := s.client.NewRequest("POST", s.client.apiEndpoint(s.APIVersion, apiEndpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	worklog := new(WorklogRecord)
+	resp, err := s.client.Do(req, worklog)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden && entry.Author != "" {
+			return nil, resp, fmt.Errorf("Logging work on issue %s for user %s requires the permission to edit all worklogs. Status code: %d", entry.IssueKey, entry.Author, resp.StatusCode)
+		}
+		return nil, resp, err
+	}
+	return worklog, resp, nil
+}
+
+// ImportBulk adds all entries, importing up to concurrency of them at once.
+// A failing entry does not stop the import of the others.
+// The results are returned in the order of entries.
+func (s *WorklogService) ImportBulk(entries []WorklogImport, concurrency int) []WorklogImportResult {
+	results := make([]WorklogImportResult, len(entries))
+	responses, errs := s.client.runBulk(len(entries), concurrency, func(i int) (*Response, error) {
+		var resp *Response
+		var err error
+		results[i].Worklog, resp, err = s.Import(entries[i])
+		return resp, err
+	})
+	for i := range results {
+		results[i].Entry = entries[i]
+		results[i].Response, results[i].Error = responses[i], errs[i]
+	}
+	return results
+}
@@ -0,0 +1,110 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorklogService_Import(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/worklog")
+
+		payload := new(worklogPayload)
+		json.NewDecoder(r.Body).Decode(payload)
+		if payload.Started != "2017-08-01T09:30:00.000+0000" {
+			t.Errorf("Expected started 2017-08-01T09:30:00.000+0000, got %s", payload.Started)
+		}
+		if payload.Author == nil || payload.Author.Name != "fred" {
+			t.Errorf("Expected author fred, got %+v", payload.Author)
+		}
+		if payload.Visibility == nil || payload.Visibility.Value != "jira-developers" {
+			t.Errorf("Expected visibility jira-developers, got %+v", payload.Visibility)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"100028","issueId":"10002","timeSpent":"3h 20m","timeSpentSeconds":12000}`)
+	})
+
+	worklog, _, err := testClient.Worklog.Import(WorklogImport{
+		IssueKey:   "EX-1",
+		Started:    time.Date(2017, 8, 1, 9, 30, 0, 0, time.UTC),
+		TimeSpent:  "3h 20m",
+		Author:     "fred",
+		Visibility: &CommentVisibility{Type: "group", Value: "jira-developers"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if worklog == nil || worklog.ID != "100028" {
+		t.Errorf("Expected worklog 100028, got %+v", worklog)
+	}
+}
+
+func TestWorklogService_Import_AuthorForbidden(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, _, err := testClient.Worklog.Import(WorklogImport{IssueKey: "EX-1", TimeSpent: "1h", Author: "fred"})
+	if err == nil || !strings.Contains(err.Error(), "permission to edit all worklogs") {
+		t.Errorf("Expected a permission error, got %v", err)
+	}
+}
+
+func TestWorklogService_ImportBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	var mu sync.Mutex
+	limited := false
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		switch r.URL.Path {
+		case "/rest/api/2/issue/EX-1/worklog":
+			mu.Lock()
+			defer mu.Unlock()
+			if !limited {
+				limited = true
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			fmt.Fprint(w, `{"id":"1"}`)
+		case "/rest/api/2/issue/EX-2/worklog":
+			fmt.Fprint(w, `{"id":"2"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	results := testClient.Worklog.ImportBulk([]WorklogImport{
+		{IssueKey: "EX-1", TimeSpent: "1h"},
+		{IssueKey: "EX-2", TimeSpent: "2h"},
+		{IssueKey: "EX-3", TimeSpent: "3h"},
+	}, 2)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	for i, id := range []string{"1", "2"} {
+		if results[i].Error != nil || results[i].Worklog == nil || results[i].Worklog.ID != id {
+			t.Errorf("Expected worklog %s for entry %d, got %+v", id, i, results[i])
+		}
+	}
+	if results[2].Error == nil || results[2].Entry.IssueKey != "EX-3" {
+		t.Errorf("Expected an error for EX-3, got %+v", results[2])
+	}
+	if stats := testClient.Stats(); stats.Retries != 1 {
+		t.Errorf("Expected 1 retry, got %d", stats.Retries)
+	}
+}